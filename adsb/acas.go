@@ -0,0 +1,50 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+// BDS30 decodes the MB field as register 3,0, ACAS active resolution
+// advisory. The MV field of a DF16 reply uses the same layout and may
+// also be decoded by converting it to CommB.
+func (c CommB) BDS30() (ResolutionAdvisory, error) {
+	var err error
+
+	switch {
+	case c.bits(1, 8) != uint64(adsbtype.BDS30):
+		err = newError(ErrInvalidRegister, "BDS mismatch")
+	case adsbtype.TTI(c.bits(29, 30)) == adsbtype.TTI3:
+		err = newError(ErrInvalidRegister, "invalid threat type")
+	case c.bits(16, 22) >= 48:
+		// the upper values are reserved for ACAS III
+		err = newError(ErrInvalidRegister, "invalid resolution advisory")
+	}
+
+	if err != nil {
+		return ResolutionAdvisory{}, newError(err, "error decoding BDS 3,0")
+	}
+
+	return c.decodeRA(), nil
+}
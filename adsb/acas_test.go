@@ -0,0 +1,110 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+func TestResolutionAdvisoryDF16(t *testing.T) {
+	m := testHexMsg(t, "8000000030800006a00a1c000000")
+
+	ra, err := m.ResolutionAdvisory()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	exp := ResolutionAdvisory{
+		ARA:        0x2000,
+		TTI:        adsbtype.TTI1,
+		ThreatICAO: 0xa80287,
+	}
+
+	if ra != exp {
+		t.Errorf("received %+v, expected %+v", ra, exp)
+	}
+}
+
+func TestResolutionAdvisoryDF20(t *testing.T) {
+	m := testHexMsg(t, "a00000003088009987064b000000")
+
+	ra, err := m.ResolutionAdvisory()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	exp := ResolutionAdvisory{
+		ARA:  0x2200,
+		RAC:  2,
+		MTE:  true,
+		TTI:  adsbtype.TTI2,
+		TIDA: 0x0c38,
+		TIDR: 25,
+		TIDB: 11,
+	}
+
+	if ra != exp {
+		t.Errorf("received %+v, expected %+v", ra, exp)
+	}
+
+	rng, err := ra.ThreatRange()
+	if err != nil || rng != 2.4 {
+		t.Errorf("ThreatRange: received %f, %v, expected 2.4", rng, err)
+	}
+
+	brg, err := ra.ThreatBearing()
+	if err != nil || brg != 60 {
+		t.Errorf("ThreatBearing: received %f, %v, expected 60", brg, err)
+	}
+}
+
+func TestBDS30Errors(t *testing.T) {
+	for _, tc := range []struct {
+		Name  string
+		Msg   string
+		Error string
+	}{
+		{"Mismatch", "a000029c85e42f313000007047d3",
+			"error retrieving resolution advisory: error decoding BDS 3,0: BDS mismatch: invalid register content"},
+		{"ThreatType", "a00000003000000c000000000000",
+			"error retrieving resolution advisory: error decoding BDS 3,0: invalid threat type: invalid register content"},
+		{"ACASIII", "800000003000c000000000000000",
+			"error retrieving resolution advisory: error decoding BDS 3,0: invalid resolution advisory: invalid register content"},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := testHexMsg(t, tc.Msg).ResolutionAdvisory()
+			if err == nil || err.Error() != tc.Error {
+				t.Errorf("expected %s, received %v", tc.Error, err)
+			}
+
+			if !errors.Is(err, ErrInvalidRegister) {
+				t.Errorf("expected %s, received %v", ErrInvalidRegister, err)
+			}
+		})
+	}
+}
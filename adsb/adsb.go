@@ -31,6 +31,12 @@ var (
 	errNotAvailable = newError(nil, "field not available")
 	errUnsupported  = newError(nil, "format unsupported")
 
+	errUncorrectable = newError(nil, "uncorrectable errors")
+
+	errImplausiblePosition = newError(nil, "implausible position")
+
+	errInvalidRegister = newError(nil, "invalid register content")
+
 	// ErrNotAvailable is used to indicate that a field is not part of the
 	// specification for the message format received. Each field error wraps
 	// ErrNotAvailable, making it accessible by calling
@@ -41,6 +47,21 @@ var (
 	// is not supported by Message. The error may be wrapped and should be
 	// checked with errors.Is().
 	ErrUnsupported = errUnsupported
+
+	// ErrUncorrectable is returned when the bit errors in a message
+	// cannot be repaired using its CRC syndrome. The error may be
+	// wrapped and should be checked with errors.Is().
+	ErrUncorrectable = errUncorrectable
+
+	// ErrImplausiblePosition is returned when a decoded position fails
+	// the checks of a PositionValidator. The error may be wrapped and
+	// should be checked with errors.Is().
+	ErrImplausiblePosition = errImplausiblePosition
+
+	// ErrInvalidRegister is returned when the content of a Comm-B
+	// message is not consistent with the register it is decoded as.
+	// The error may be wrapped and should be checked with errors.Is().
+	ErrInvalidRegister = errInvalidRegister
 )
 
 // adsbError is the error type for the adsb library.
@@ -22,14 +22,55 @@
 
 package adsb
 
+import (
+	"math"
+
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
 // decodeAC decodes the Altitude Code field to an altitude in feet.
 func decodeAC(a uint64) (int64, error) {
-	if a == 0 || a&0xffffffffffffe000 != 0 {
+	_, ft, _, err := decodeACUnit(a)
+
+	return ft, err
+}
+
+// decodeACUnit decodes the Altitude Code field to an altitude in both
+// meters and feet, and the unit in which it was encoded.
+func decodeACUnit(a uint64) (int64, int64, adsbtype.M, error) {
+	if a&0b0000001000000 != 0 { // M bit designates feet vs meters
+		m, err := decodeACMetric(a)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+
+		return m, int64(math.Round(float64(m) / FEET_TO_METER)), adsbtype.M1, nil
+	}
+
+	ft, err := decodeACFeet(a)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return int64(math.Round(float64(ft) * FEET_TO_METER)), ft, adsbtype.M0, nil
+}
+
+// decodeACMetric decodes an Altitude Code field with the M bit set to
+// an altitude in meters. The remaining 12 bits are a binary value in 1
+// meter increments.
+func decodeACMetric(a uint64) (int64, error) {
+	if a&0xffffffffffffe000 != 0 {
 		return 0, newError(nil, "invalid altitude data")
 	}
 
-	if a&0b0000001000000 != 0 { // M bit designates feet vs meters
-		return 0, newError(nil, "metric altitude not supported")
+	return int64(((a & 0b1111110000000) >> 1) | (a & 0b0000000111111)), nil
+}
+
+// decodeACFeet decodes an Altitude Code field with the M bit clear to
+// an altitude in feet.
+func decodeACFeet(a uint64) (int64, error) {
+	if a == 0 || a&0xffffffffffffe000 != 0 {
+		return 0, newError(nil, "invalid altitude data")
 	}
 
 	if a&0b0000000010000 == 0 { // Q bit designates 100 ft vs 25 ft increments
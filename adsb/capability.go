@@ -0,0 +1,147 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+// DataLinkCapability is the content of Comm-B register 1,0, data link
+// capability report.
+type DataLinkCapability struct {
+	Continuation bool // true if further capability is reported in register 1,1
+
+	OverlayCommand bool // overlay command capability
+	ACAS           bool // true if ACAS is operating
+
+	Version uint8 // Mode S subnetwork version number, 0 if not available
+
+	EnhancedProtocol bool // transponder enhanced protocol indicator
+	SpecificServices bool // Mode S specific services capability
+
+	UplinkELM   uint8 // uplink ELM average throughput capability
+	DownlinkELM uint8 // downlink ELM throughput capability
+
+	Identification         bool // aircraft identification capability
+	Squitter               bool // squitter capability subfield (SCS)
+	SurveillanceIdentifier bool // surveillance identifier code (SIC)
+	GICBChanged            bool // common usage GICB capability report changed
+
+	HybridSurveillance bool  // ACAS hybrid surveillance capability
+	ACASRA             bool  // true if ACAS generates both TAs and RAs
+	ACASVersion        uint8 // ACAS RTCA DO-185 version indicator, bits 39 and 40
+
+	DTE uint16 // data terminal equipment status, one bit per subaddress
+}
+
+// GICBCapability is the content of Comm-B register 1,7, common usage
+// GICB capability report.
+type GICBCapability struct {
+	Registers []adsbtype.BDS // registers reported as supported
+}
+
+// gicbBits is the register represented by each of bits 1-24 of
+// register 1,7.
+var gicbBits = []adsbtype.BDS{
+	adsbtype.BDS05, adsbtype.BDS06, adsbtype.BDS07, adsbtype.BDS08,
+	adsbtype.BDS09, adsbtype.BDS0A, adsbtype.BDS20, adsbtype.BDS21,
+	adsbtype.BDS40, adsbtype.BDS41, adsbtype.BDS42, adsbtype.BDS43,
+	adsbtype.BDS44, adsbtype.BDS45, adsbtype.BDS48, adsbtype.BDS50,
+	adsbtype.BDS51, adsbtype.BDS52, adsbtype.BDS53, adsbtype.BDS54,
+	adsbtype.BDS55, adsbtype.BDS56, adsbtype.BDS5F, adsbtype.BDS60,
+}
+
+// Supports reports whether register bds is reported as supported.
+func (g GICBCapability) Supports(bds adsbtype.BDS) bool {
+	for _, r := range g.Registers {
+		if r == bds {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BDS10 decodes the MB field as register 1,0, data link capability
+// report.
+func (c CommB) BDS10() (DataLinkCapability, error) {
+	err := c.reserved(10, 14)
+
+	if c.bits(1, 8) != uint64(adsbtype.BDS10) {
+		err = newError(ErrInvalidRegister, "BDS mismatch")
+	}
+
+	// the overlay command capability was introduced with subnetwork
+	// version 5
+	if err == nil && c.bit(15) != (c.bits(17, 23) >= 5) {
+		err = newError(ErrInvalidRegister, "overlay capability inconsistent with version")
+	}
+
+	if err != nil {
+		return DataLinkCapability{}, newError(err, "error decoding BDS 1,0")
+	}
+
+	return DataLinkCapability{
+		Continuation:           c.bit(9),
+		OverlayCommand:         c.bit(15),
+		ACAS:                   c.bit(16),
+		Version:                uint8(c.bits(17, 23)),
+		EnhancedProtocol:       c.bit(24),
+		SpecificServices:       c.bit(25),
+		UplinkELM:              uint8(c.bits(26, 28)),
+		DownlinkELM:            uint8(c.bits(29, 32)),
+		Identification:         c.bit(33),
+		Squitter:               c.bit(34),
+		SurveillanceIdentifier: c.bit(35),
+		GICBChanged:            c.bit(36),
+		HybridSurveillance:     c.bit(37),
+		ACASRA:                 c.bit(38),
+		ACASVersion:            uint8(c.bits(39, 40)),
+		DTE:                    uint16(c.bits(41, 56)),
+	}, nil
+}
+
+// BDS17 decodes the MB field as register 1,7, common usage GICB
+// capability report.
+func (c CommB) BDS17() (GICBCapability, error) {
+	err := c.check(c.reserved(25, 56))
+
+	// a transponder reporting GICB capability must support 2,0
+	if err == nil && !c.bit(7) {
+		err = newError(ErrInvalidRegister, "BDS 2,0 not supported")
+	}
+
+	if err != nil {
+		return GICBCapability{}, newError(err, "error decoding BDS 1,7")
+	}
+
+	var g GICBCapability
+
+	for i, bds := range gicbBits {
+		if c.bit(i + 1) {
+			g.Registers = append(g.Registers, bds)
+		}
+	}
+
+	return g, nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+func TestBDS10(t *testing.T) {
+	d, err := testCommB(t, "a800178d10010080f50000d5893c").BDS10()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	exp := DataLinkCapability{
+		ACAS:                   true,
+		SpecificServices:       true,
+		Identification:         true,
+		Squitter:               true,
+		SurveillanceIdentifier: true,
+		GICBChanged:            true,
+		ACASRA:                 true,
+		ACASVersion:            1,
+	}
+
+	if d != exp {
+		t.Errorf("received %+v, expected %+v", d, exp)
+	}
+}
+
+func TestBDS17(t *testing.T) {
+	g, err := testCommB(t, "a0000638fa81c10000000081a92f").BDS17()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	exp := []adsbtype.BDS{
+		adsbtype.BDS05, adsbtype.BDS06, adsbtype.BDS07, adsbtype.BDS08,
+		adsbtype.BDS09, adsbtype.BDS20, adsbtype.BDS40, adsbtype.BDS50,
+		adsbtype.BDS51, adsbtype.BDS52, adsbtype.BDS60,
+	}
+
+	if !reflect.DeepEqual(g.Registers, exp) {
+		t.Errorf("received %v, expected %v", g.Registers, exp)
+	}
+
+	if !g.Supports(adsbtype.BDS40) {
+		t.Error("expected support for BDS 4,0")
+	}
+
+	if g.Supports(adsbtype.BDS44) {
+		t.Error("expected no support for BDS 4,4")
+	}
+}
+
+func TestCapabilityErrors(t *testing.T) {
+	for _, tc := range []struct {
+		Name   string
+		Msg    string
+		Decode func(CommB) error
+		Error  string
+	}{
+		{"BDS10Mismatch", "a000083e202cc371c31de0aa1ccf",
+			func(c CommB) error { _, err := c.BDS10(); return err },
+			"error decoding BDS 1,0: BDS mismatch: invalid register content"},
+		{"BDS10Reserved", "a800178d10410080f50000d5893c",
+			func(c CommB) error { _, err := c.BDS10(); return err },
+			"error decoding BDS 1,0: reserved bits 10-14 set: invalid register content"},
+		{"BDS10Overlay", "a800178d10030080f50000d5893c",
+			func(c CommB) error { _, err := c.BDS10(); return err },
+			"error decoding BDS 1,0: overlay capability inconsistent with version: invalid register content"},
+		{"BDS17Reserved", "a000083e202cc371c31de0aa1ccf",
+			func(c CommB) error { _, err := c.BDS17(); return err },
+			"error decoding BDS 1,7: reserved bits 25-56 set: invalid register content"},
+		{"BDS17NoBDS20", "a0000638f881c10000000081a92f",
+			func(c CommB) error { _, err := c.BDS17(); return err },
+			"error decoding BDS 1,7: BDS 2,0 not supported: invalid register content"},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Decode(testCommB(t, tc.Msg))
+			if err == nil || err.Error() != tc.Error {
+				t.Errorf("expected %s, received %v", tc.Error, err)
+			}
+
+			if !errors.Is(err, ErrInvalidRegister) {
+				t.Errorf("expected %s, received %v", ErrInvalidRegister, err)
+			}
+		})
+	}
+}
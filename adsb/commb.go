@@ -0,0 +1,92 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"strings"
+)
+
+// CommB is the 56 bit MB field of a Comm-B reply, as returned by
+// RawMessage.MB. The register it contains is not identified in the
+// reply, so each decoding method checks that the content is consistent
+// with its register and returns an error wrapping ErrInvalidRegister if
+// it is not.
+type CommB uint64
+
+// CommB returns the MB field of a DF20 or DF21 reply.
+func (m *Message) CommB() (CommB, error) {
+	mb, err := m.raw.MB()
+	if err != nil {
+		return 0, newError(err, "error retrieving Comm-B data")
+	}
+
+	return CommB(mb), nil
+}
+
+// bits returns bits n through z of the MB field, where the first bit is
+// numbered 1.
+func (c CommB) bits(n int, z int) uint64 {
+	return (uint64(c) >> (56 - z)) & (1<<(z-n+1) - 1)
+}
+
+// bit reports whether bit n of the MB field is set.
+func (c CommB) bit(n int) bool {
+	return c.bits(n, n) == 1
+}
+
+// signed returns bits n through z as a two's complement value, where
+// bit n is the sign.
+func (c CommB) signed(n int, z int) int64 {
+	v := int64(c.bits(n, z))
+
+	if c.bit(n) {
+		v -= 1 << (z - n + 1)
+	}
+
+	return v
+}
+
+// chars decodes count characters of the 6 bit character set used by
+// Call, starting at bit n. Trailing spaces are removed. An error is
+// returned if any character is not part of the set.
+func (c CommB) chars(n int, count int) (string, error) {
+	b := make([]byte, count)
+
+	for i := range b {
+		b[i] = callChars[c.bits(n+i*6, n+i*6+5)]
+		if b[i] == '?' {
+			return "", newError(ErrInvalidRegister, "invalid character")
+		}
+	}
+
+	return strings.TrimRight(string(b), " "), nil
+}
+
+// reserved returns an error if any of bits n through z are set.
+func (c CommB) reserved(n int, z int) error {
+	if c.bits(n, z) != 0 {
+		return newErrorf(ErrInvalidRegister, "reserved bits %d-%d set", n, z)
+	}
+
+	return nil
+}
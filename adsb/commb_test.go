@@ -0,0 +1,50 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCommBError(t *testing.T) {
+	m := testHexMsg(t, "8dacf84e23101332cf3ca037ef13")
+
+	_, err := m.CommB()
+	if !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("expected %s, received %v", ErrNotAvailable, err)
+	}
+}
+
+// testCommB returns the MB field of the Comm-B reply in the hex string
+// s.
+func testCommB(t *testing.T, s string) CommB {
+	t.Helper()
+
+	c, err := testHexMsg(t, s).CommB()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	return c
+}
@@ -0,0 +1,139 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"sync"
+)
+
+// Bits 1 through 5 hold the downlink format. Correcting them would
+// change the meaning of the message, so they are never flipped.
+const firstFixableBit = 6
+
+var (
+	synOnce sync.Once
+	synTbl  map[uint64][]int
+)
+
+// buildSyndromes populates the table of syndromes produced by one and
+// two bit errors in a 112 bit message. Syndromes which can be produced
+// by more than one error pattern are stored as nil so they are never
+// corrected.
+func buildSyndromes() {
+	synTbl = make(map[uint64][]int)
+
+	add := func(s uint64, bits ...int) {
+		if _, ok := synTbl[s]; ok {
+			synTbl[s] = nil
+
+			return
+		}
+
+		synTbl[s] = bits
+	}
+
+	for i := firstFixableBit; i <= 112; i++ {
+		add(bitSyndrome(i), i)
+	}
+
+	for i := firstFixableBit; i <= 112; i++ {
+		for j := i + 1; j <= 112; j++ {
+			add(bitSyndrome(i)^bitSyndrome(j), i, j)
+		}
+	}
+}
+
+// bitSyndrome returns the syndrome produced by an error in bit n of a
+// 112 bit message.
+func bitSyndrome(n int) uint64 {
+	if n <= 88 {
+		return pTbl[n-1]
+	}
+
+	return 1 << uint(112-n)
+}
+
+// Syndrome returns the CRC syndrome of an extended squitter message,
+// which is the calculated parity combined with the transmitted Parity /
+// Interrogator Identifier field. A syndrome of zero indicates that no
+// errors were detected.
+func (r *RawMessage) Syndrome() (uint64, error) {
+	df, err := r.DF()
+	if err != nil {
+		return 0, err
+	}
+
+	switch df {
+	case 17, 18:
+		pi, err := r.PI()
+		if err != nil {
+			return 0, err
+		}
+
+		return r.Parity() ^ pi, nil
+	default:
+		return 0, newErrorf(ErrNotAvailable, "error retrieving %s from %d",
+			"Syndrome", df)
+	}
+}
+
+// CorrectErrors attempts to repair up to maxBits (1 or 2) bit errors in
+// an extended squitter message using its CRC syndrome. On success the
+// message data is modified in place and the numbers of the corrected
+// bits are returned, where the first bit is numbered 1. A message with
+// no errors returns an empty slice.
+//
+// If the errors cannot be corrected the message is left unchanged and
+// the returned error wraps ErrUncorrectable.
+func (r *RawMessage) CorrectErrors(maxBits int) ([]int, error) {
+	if maxBits < 1 || maxBits > 2 {
+		return nil, newErrorf(nil, "unsupported number of bits: %d", maxBits)
+	}
+
+	s, err := r.Syndrome()
+	if err != nil {
+		return nil, err
+	}
+
+	if s == 0 {
+		return []int{}, nil
+	}
+
+	synOnce.Do(buildSyndromes)
+
+	bits := synTbl[s]
+	if len(bits) == 0 || len(bits) > maxBits {
+		return nil, newErrorf(ErrUncorrectable, "syndrome %06x", s)
+	}
+
+	data := r.data.Bytes()
+
+	for _, n := range bits {
+		data[(n-1)/8] ^= 0x80 >> uint((n-1)%8)
+	}
+
+	fixed := make([]int, len(bits))
+	copy(fixed, bits)
+
+	return fixed, nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestCorrectErrors(t *testing.T) {
+	t.Run("NoError", testCorrectNoError)
+	t.Run("OneBit", testCorrectOneBit)
+	t.Run("OneBitParity", testCorrectOneBitParity)
+	t.Run("TwoBits", testCorrectTwoBits)
+	t.Run("TwoBitsLimited", testCorrectTwoBitsLimited)
+	t.Run("ThreeBits", testCorrectThreeBits)
+	t.Run("Unsupported", testCorrectUnsupported)
+}
+
+const crcTestMsg = "8da2f111581fb4842d1f59eea2b7"
+
+func testCorrectNoError(t *testing.T) {
+	testCorrect(t, nil, 2, []int{})
+}
+
+func testCorrectOneBit(t *testing.T) {
+	testCorrect(t, []int{40}, 1, []int{40})
+}
+
+func testCorrectOneBitParity(t *testing.T) {
+	testCorrect(t, []int{100}, 1, []int{100})
+}
+
+func testCorrectTwoBits(t *testing.T) {
+	testCorrect(t, []int{12, 77}, 2, []int{12, 77})
+}
+
+func testCorrectTwoBitsLimited(t *testing.T) {
+	testCorrect(t, []int{12, 77}, 1, nil)
+}
+
+func testCorrectThreeBits(t *testing.T) {
+	testCorrect(t, []int{12, 50, 77}, 2, nil)
+}
+
+func testCorrectUnsupported(t *testing.T) {
+	b, err := hex.DecodeString("5daa234a912889")
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	rm := new(RawMessage)
+
+	err = rm.UnmarshalBinary(b)
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	_, err = rm.CorrectErrors(1)
+	if !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("expected ErrNotAvailable, received %v", err)
+	}
+}
+
+// testCorrect flips the bits in flip, attempts correction and compares
+// the result to the original message. A nil exp indicates that the
+// correction is expected to fail.
+func testCorrect(t *testing.T, flip []int, max int, exp []int) {
+	t.Helper()
+
+	orig, err := hex.DecodeString(crcTestMsg)
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	b := make([]byte, len(orig))
+	copy(b, orig)
+
+	for _, n := range flip {
+		b[(n-1)/8] ^= 0x80 >> uint((n-1)%8)
+	}
+
+	rm := new(RawMessage)
+
+	err = rm.UnmarshalBinary(b)
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	fixed, err := rm.CorrectErrors(max)
+
+	if exp == nil {
+		if !errors.Is(err, ErrUncorrectable) {
+			t.Errorf("expected ErrUncorrectable, received %v", err)
+		}
+
+		if hex.EncodeToString(rm.data.Bytes()) != hex.EncodeToString(b) {
+			t.Error("message modified after failed correction")
+		}
+
+		return
+	}
+
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	if len(fixed) != len(exp) {
+		t.Fatalf("expected %v, received %v", exp, fixed)
+	}
+
+	for i := range exp {
+		if fixed[i] != exp[i] {
+			t.Errorf("expected %v, received %v", exp, fixed)
+		}
+	}
+
+	if hex.EncodeToString(rm.data.Bytes()) != crcTestMsg {
+		t.Errorf("expected %s, received %x", crcTestMsg, rm.data.Bytes())
+	}
+
+	s, err := rm.Syndrome()
+	if err != nil || s != 0 {
+		t.Errorf("expected zero syndrome, received %06x %v", s, err)
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"math"
+)
+
+// SelectedIntention is the content of Comm-B register 4,0, selected
+// vertical intention. Each value is only meaningful if its
+// corresponding Valid field is true.
+type SelectedIntention struct {
+	MCPAlt      int64 // MCP / FCU selected altitude in feet
+	MCPAltValid bool
+
+	FMSAlt      int64 // FMS selected altitude in feet
+	FMSAltValid bool
+
+	BaroSetting      float64 // barometric pressure setting in hPa
+	BaroSettingValid bool
+
+	ModeValid bool // true if the following mode flags are valid
+	VNAV      bool // vertical navigation mode engaged
+	AltHold   bool // altitude hold mode engaged
+	Approach  bool // approach mode engaged
+
+	AltSource      uint8 // 0 unknown, 1 aircraft altitude, 2 MCP / FCU, 3 FMS
+	AltSourceValid bool
+}
+
+// TrackTurn is the content of Comm-B register 5,0, track and turn
+// report. Speeds are in m/s and angles in degrees. Each value is only
+// meaningful if its corresponding Valid field is true.
+type TrackTurn struct {
+	Roll      float64 // roll angle, positive for right wing down
+	RollValid bool
+
+	Track      float64 // true track angle [0, 360)
+	TrackValid bool
+
+	GroundSpeed      float64
+	GroundSpeedValid bool
+
+	TrackRate      float64 // track angle rate in degrees per second
+	TrackRateValid bool
+
+	TAS      float64 // true airspeed
+	TASValid bool
+}
+
+// HeadingSpeed is the content of Comm-B register 6,0, heading and speed
+// report. Speeds are in m/s and angles in degrees. Each value is only
+// meaningful if its corresponding Valid field is true.
+type HeadingSpeed struct {
+	Heading      float64 // magnetic heading [0, 360)
+	HeadingValid bool
+
+	IAS      float64 // indicated airspeed
+	IASValid bool
+
+	Mach      float64
+	MachValid bool
+
+	BaroRate      float64 // barometric altitude rate in m/s
+	BaroRateValid bool
+
+	InertialRate      float64 // inertial vertical velocity in m/s
+	InertialRateValid bool
+}
+
+// Limits used to check whether data is consistent with a register.
+const (
+	maxSelectedAlt = 50000 // feet
+	maxRoll        = 50    // degrees
+	maxGroundSpeed = 600   // knots
+	maxTAS         = 500   // knots
+	maxIAS         = 500   // knots
+	maxMach        = 1
+	maxTASDiff     = 200  // knots between true airspeed and ground speed
+	maxVertRate    = 6000 // feet per minute
+)
+
+// BDS40 decodes the MB field as register 4,0, selected vertical
+// intention.
+func (c CommB) BDS40() (SelectedIntention, error) {
+	var s SelectedIntention
+
+	err := c.check(
+		c.status(1, 2, 13),
+		c.status(14, 15, 26),
+		c.status(27, 28, 39),
+		c.reserved(40, 47),
+		c.status(48, 49, 51),
+		c.reserved(52, 53),
+		c.status(54, 55, 56),
+	)
+	if err != nil {
+		return SelectedIntention{}, newError(err, "error decoding BDS 4,0")
+	}
+
+	if s.MCPAltValid = c.bit(1); s.MCPAltValid {
+		s.MCPAlt = int64(c.bits(2, 13)) * 16
+	}
+
+	if s.FMSAltValid = c.bit(14); s.FMSAltValid {
+		s.FMSAlt = int64(c.bits(15, 26)) * 16
+	}
+
+	if s.BaroSettingValid = c.bit(27); s.BaroSettingValid {
+		s.BaroSetting = 800 + float64(c.bits(28, 39))*0.1
+	}
+
+	if s.ModeValid = c.bit(48); s.ModeValid {
+		s.VNAV = c.bit(49)
+		s.AltHold = c.bit(50)
+		s.Approach = c.bit(51)
+	}
+
+	if s.AltSourceValid = c.bit(54); s.AltSourceValid {
+		s.AltSource = uint8(c.bits(55, 56))
+	}
+
+	if s.MCPAlt > maxSelectedAlt || s.FMSAlt > maxSelectedAlt {
+		return SelectedIntention{}, newError(
+			newError(ErrInvalidRegister, "selected altitude out of range"),
+			"error decoding BDS 4,0")
+	}
+
+	return s, nil
+}
+
+// BDS50 decodes the MB field as register 5,0, track and turn report.
+func (c CommB) BDS50() (TrackTurn, error) {
+	var t TrackTurn
+
+	err := c.check(
+		c.status(1, 2, 11),
+		c.status(12, 13, 23),
+		c.status(24, 25, 34),
+		c.status(35, 36, 45),
+		c.status(46, 47, 56),
+	)
+	if err != nil {
+		return TrackTurn{}, newError(err, "error decoding BDS 5,0")
+	}
+
+	if t.RollValid = c.bit(1); t.RollValid {
+		t.Roll = float64(c.signed(2, 11)) * 45 / 256
+	}
+
+	if t.TrackValid = c.bit(12); t.TrackValid {
+		t.Track = math.Mod(float64(c.signed(13, 23))*90/512+360, 360)
+	}
+
+	gs := float64(c.bits(25, 34)) * 2
+	if t.GroundSpeedValid = c.bit(24); t.GroundSpeedValid {
+		t.GroundSpeed = gs * KNOT_TO_MPS
+	}
+
+	if t.TrackRateValid = c.bit(35); t.TrackRateValid {
+		t.TrackRate = float64(c.signed(36, 45)) * 8 / 256
+	}
+
+	tas := float64(c.bits(47, 56)) * 2
+	if t.TASValid = c.bit(46); t.TASValid {
+		t.TAS = tas * KNOT_TO_MPS
+	}
+
+	switch {
+	case math.Abs(t.Roll) > maxRoll:
+		err = newError(ErrInvalidRegister, "roll angle out of range")
+	case gs > maxGroundSpeed:
+		err = newError(ErrInvalidRegister, "ground speed out of range")
+	case tas > maxTAS:
+		err = newError(ErrInvalidRegister, "true airspeed out of range")
+	case t.GroundSpeedValid && t.TASValid && math.Abs(tas-gs) > maxTASDiff:
+		err = newError(ErrInvalidRegister, "true airspeed inconsistent with ground speed")
+	}
+
+	if err != nil {
+		return TrackTurn{}, newError(err, "error decoding BDS 5,0")
+	}
+
+	return t, nil
+}
+
+// BDS60 decodes the MB field as register 6,0, heading and speed report.
+func (c CommB) BDS60() (HeadingSpeed, error) {
+	var h HeadingSpeed
+
+	err := c.check(
+		c.status(1, 2, 12),
+		c.status(13, 14, 23),
+		c.status(24, 25, 34),
+		c.status(35, 36, 45),
+		c.status(46, 47, 56),
+	)
+	if err != nil {
+		return HeadingSpeed{}, newError(err, "error decoding BDS 6,0")
+	}
+
+	if h.HeadingValid = c.bit(1); h.HeadingValid {
+		h.Heading = math.Mod(float64(c.signed(2, 12))*90/512+360, 360)
+	}
+
+	ias := float64(c.bits(14, 23))
+	if h.IASValid = c.bit(13); h.IASValid {
+		h.IAS = ias * KNOT_TO_MPS
+	}
+
+	if h.MachValid = c.bit(24); h.MachValid {
+		h.Mach = float64(c.bits(25, 34)) * 2.048 / 512
+	}
+
+	baro := float64(c.signed(36, 45)) * 32
+	if h.BaroRateValid = c.bit(35); h.BaroRateValid {
+		h.BaroRate = baro * FEET_PER_MIN_TO_MPS
+	}
+
+	ins := float64(c.signed(47, 56)) * 32
+	if h.InertialRateValid = c.bit(46); h.InertialRateValid {
+		h.InertialRate = ins * FEET_PER_MIN_TO_MPS
+	}
+
+	switch {
+	case ias > maxIAS:
+		err = newError(ErrInvalidRegister, "indicated airspeed out of range")
+	case h.Mach > maxMach:
+		err = newError(ErrInvalidRegister, "mach number out of range")
+	case math.Abs(baro) > maxVertRate || math.Abs(ins) > maxVertRate:
+		err = newError(ErrInvalidRegister, "vertical rate out of range")
+	}
+
+	if err != nil {
+		return HeadingSpeed{}, newError(err, "error decoding BDS 6,0")
+	}
+
+	return h, nil
+}
+
+// status returns an error if the status bit s is clear but any of bits
+// n through z are set.
+func (c CommB) status(s int, n int, z int) error {
+	if c.bit(s) || c.bits(n, z) == 0 {
+		return nil
+	}
+
+	return newErrorf(ErrInvalidRegister, "status bit %d clear with data", s)
+}
+
+// check returns the first non-nil error, or an error if the MB field is
+// empty.
+func (c CommB) check(errs ...error) error {
+	if c == 0 {
+		return newError(ErrInvalidRegister, "no data")
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
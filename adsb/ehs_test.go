@@ -0,0 +1,150 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestBDS40(t *testing.T) {
+	c := testCommB(t, "a000029c85e42f313000007047d3")
+
+	s, err := c.BDS40()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	exp := SelectedIntention{
+		MCPAlt:           3008,
+		MCPAltValid:      true,
+		FMSAlt:           3008,
+		FMSAltValid:      true,
+		BaroSetting:      1020,
+		BaroSettingValid: true,
+	}
+
+	if s != exp {
+		t.Errorf("received %+v, expected %+v", s, exp)
+	}
+}
+
+func TestBDS50(t *testing.T) {
+	c := testCommB(t, "a000139381951536e024d4ccf6b5")
+
+	s, err := c.BDS50()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	if !s.RollValid || !s.TrackValid || !s.GroundSpeedValid || !s.TrackRateValid || !s.TASValid {
+		t.Errorf("received unexpected status %+v", s)
+	}
+
+	for _, v := range []struct {
+		Name string
+		Val  float64
+		Exp  float64
+	}{
+		{"Roll", s.Roll, 2.109375},
+		{"Track", s.Track, 114.2578125},
+		{"GroundSpeed", s.GroundSpeed, 438 * KNOT_TO_MPS},
+		{"TrackRate", s.TrackRate, 0.125},
+		{"TAS", s.TAS, 424 * KNOT_TO_MPS},
+	} {
+		if math.Abs(v.Val-v.Exp) > 1e-6 {
+			t.Errorf("%s: received %f, expected %f", v.Name, v.Val, v.Exp)
+		}
+	}
+}
+
+func TestBDS60(t *testing.T) {
+	c := testCommB(t, "a00004128f39f91a7e27c46adc21")
+
+	s, err := c.BDS60()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	if !s.HeadingValid || !s.IASValid || !s.MachValid || !s.BaroRateValid || !s.InertialRateValid {
+		t.Errorf("received unexpected status %+v", s)
+	}
+
+	for _, v := range []struct {
+		Name string
+		Val  float64
+		Exp  float64
+	}{
+		{"Heading", s.Heading, 42.71484375},
+		{"IAS", s.IAS, 252 * KNOT_TO_MPS},
+		{"Mach", s.Mach, 0.42},
+		{"BaroRate", s.BaroRate, -1920 * FEET_PER_MIN_TO_MPS},
+		{"InertialRate", s.InertialRate, -1920 * FEET_PER_MIN_TO_MPS},
+	} {
+		if math.Abs(v.Val-v.Exp) > 1e-6 {
+			t.Errorf("%s: received %f, expected %f", v.Name, v.Val, v.Exp)
+		}
+	}
+}
+
+func TestEHSErrors(t *testing.T) {
+	for _, tc := range []struct {
+		Name   string
+		Msg    string
+		Decode func(CommB) error
+		Error  string
+	}{
+		{"BDS40Reserved", "a000139381951536e024d4ccf6b5",
+			func(c CommB) error { _, err := c.BDS40(); return err },
+			"error decoding BDS 4,0: reserved bits 40-47 set: invalid register content"},
+		{"BDS40Status", "a00004128f39f91a7e27c46adc21",
+			func(c CommB) error { _, err := c.BDS40(); return err },
+			"error decoding BDS 4,0: status bit 14 clear with data: invalid register content"},
+		{"BDS50Status", "a000029c85e42f313000007047d3",
+			func(c CommB) error { _, err := c.BDS50(); return err },
+			"error decoding BDS 5,0: status bit 12 clear with data: invalid register content"},
+		{"BDS50Range", "a00004128f39f91a7e27c46adc21",
+			func(c CommB) error { _, err := c.BDS50(); return err },
+			"error decoding BDS 5,0: true airspeed out of range: invalid register content"},
+		{"BDS60Status", "a000139381951536e024d4ccf6b5",
+			func(c CommB) error { _, err := c.BDS60(); return err },
+			"error decoding BDS 6,0: status bit 13 clear with data: invalid register content"},
+		{"Empty", "a000000000000000000000000000",
+			func(c CommB) error { _, err := c.BDS60(); return err },
+			"error decoding BDS 6,0: no data: invalid register content"},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Decode(testCommB(t, tc.Msg))
+			if err == nil || err.Error() != tc.Error {
+				t.Errorf("expected %s, received %v", tc.Error, err)
+			}
+
+			if !errors.Is(err, ErrInvalidRegister) {
+				t.Errorf("expected %s, received %v", ErrInvalidRegister, err)
+			}
+		})
+	}
+}
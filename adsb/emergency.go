@@ -0,0 +1,164 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+// Emergency returns the emergency state from an emergency status
+// message, type code 28 subtype 1. The accompanying Mode A code is
+// available from Sqk.
+func (m *Message) Emergency() (adsbtype.EMS, error) {
+	err := m.validateTC28(1)
+	if err != nil {
+		return 0, newError(err, "error retrieving emergency state")
+	}
+
+	return adsbtype.EMS(m.raw.Bits(41, 43)), nil
+}
+
+// ResolutionAdvisory is an ACAS resolution advisory report.
+type ResolutionAdvisory struct {
+	ARA uint64       // active resolution advisories, 14 bits
+	RAC uint64       // resolution advisory complements, 4 bits
+	RAT bool         // true if the RA has been terminated
+	MTE bool         // true if there are multiple threats
+	TTI adsbtype.TTI // threat type indicator
+
+	ThreatICAO uint64 // threat Mode S address, if TTI is 1
+
+	TIDA uint64 // threat altitude code, if TTI is 2
+	TIDR uint64 // threat range code, if TTI is 2
+	TIDB uint64 // threat bearing code, if TTI is 2
+}
+
+// ResolutionAdvisory returns an ACAS resolution advisory report. It is
+// available from the RA broadcast of an emergency status message, type
+// code 28 subtype 2, from the MV field of a DF16 reply and from the MB
+// field of a DF20 or DF21 reply. The MV and MB fields must be
+// consistent with register 3,0.
+func (m *Message) ResolutionAdvisory() (ResolutionAdvisory, error) {
+	df, err := m.raw.DF()
+	if err != nil {
+		return ResolutionAdvisory{}, newError(err, "error retrieving resolution advisory")
+	}
+
+	var ra ResolutionAdvisory
+
+	switch df {
+	case 16, 20, 21:
+		ra, err = CommB(m.raw.Bits(33, 88)).BDS30()
+	default:
+		err = m.validateTC28(2)
+		if err != nil {
+			return ResolutionAdvisory{}, newError(err, "error retrieving resolution advisory")
+		}
+
+		ra = CommB(m.raw.Bits(33, 88)).decodeRA()
+	}
+
+	if err != nil {
+		return ResolutionAdvisory{}, newError(err, "error retrieving resolution advisory")
+	}
+
+	return ra, nil
+}
+
+// validateTC28 returns an error if the message is not an emergency
+// status message of subtype st.
+func (m *Message) validateTC28(st uint64) error {
+	tc, err := m.raw.ESType()
+	if err != nil {
+		return err
+	}
+
+	if tc != 28 {
+		return newErrorf(ErrNotAvailable, "type code %d", tc)
+	}
+
+	if m.raw.Bits(38, 40) != st {
+		return newErrorf(ErrNotAvailable, "subtype %d", m.raw.Bits(38, 40))
+	}
+
+	return nil
+}
+
+// decodeRA decodes a resolution advisory from a 56 bit data field. The
+// layout is shared by the extended squitter RA broadcast, the MV field
+// of DF16 and Comm-B register 3,0.
+func (c CommB) decodeRA() ResolutionAdvisory {
+	ra := ResolutionAdvisory{
+		ARA: c.bits(9, 22),
+		RAC: c.bits(23, 26),
+		RAT: c.bit(27),
+		MTE: c.bit(28),
+		TTI: adsbtype.TTI(c.bits(29, 30)),
+	}
+
+	switch ra.TTI {
+	case adsbtype.TTI1:
+		ra.ThreatICAO = c.bits(31, 54)
+	case adsbtype.TTI2:
+		ra.TIDA = c.bits(31, 43)
+		ra.TIDR = c.bits(44, 50)
+		ra.TIDB = c.bits(51, 56)
+	}
+
+	return ra
+}
+
+// ThreatAlt returns the altitude of the threat in feet.
+func (ra ResolutionAdvisory) ThreatAlt() (int64, error) {
+	if ra.TTI != adsbtype.TTI2 {
+		return 0, newError(ErrNotAvailable, "threat altitude not available")
+	}
+
+	return decodeAC(ra.TIDA)
+}
+
+// ThreatRange returns the range to the threat in nautical miles. A range
+// of less than 0.05 NM is returned as 0.05, and a range of more than
+// 12.55 NM is returned as 12.6.
+func (ra ResolutionAdvisory) ThreatRange() (float64, error) {
+	if ra.TTI != adsbtype.TTI2 || ra.TIDR == 0 {
+		return 0, newError(ErrNotAvailable, "threat range not available")
+	}
+
+	if ra.TIDR == 1 {
+		return 0.05, nil
+	}
+
+	return float64(ra.TIDR-1) / 10, nil
+}
+
+// ThreatBearing returns the bearing to the threat in degrees clockwise
+// from the aircraft heading. The bearing is reported in 6 degree
+// sectors, and the start of the sector is returned.
+func (ra ResolutionAdvisory) ThreatBearing() (float64, error) {
+	if ra.TTI != adsbtype.TTI2 || ra.TIDB == 0 || ra.TIDB > 60 {
+		return 0, newError(ErrNotAvailable, "threat bearing not available")
+	}
+
+	return float64(ra.TIDB-1) * 6, nil
+}
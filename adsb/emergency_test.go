@@ -0,0 +1,172 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+func TestEmergency(t *testing.T) {
+	m := testHexMsg(t, "8da80287e1aaa200000000db65a4")
+
+	ems, err := m.Emergency()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	if ems != adsbtype.EMS5 {
+		t.Errorf("Emergency: received %s, expected %s", ems, adsbtype.EMS5)
+	}
+
+	sqk, err := m.Sqk()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	if !bytes.Equal(sqk, []byte{7, 5, 0, 0}) {
+		t.Errorf("Sqk: received %v, expected %v", sqk, []byte{7, 5, 0, 0})
+	}
+
+	_, err = m.ResolutionAdvisory()
+	if err == nil || err.Error() != "error retrieving resolution advisory: subtype 1: field not available" {
+		t.Errorf("received unexpected error %v", err)
+	}
+}
+
+func TestResolutionAdvisory(t *testing.T) {
+	t.Run("ThreatICAO", testRAThreatICAO)
+	t.Run("ThreatPosition", testRAThreatPosition)
+	t.Run("Errors", testRAErrors)
+}
+
+func testRAThreatICAO(t *testing.T) {
+	m := testHexMsg(t, "8da80287e2900096af37bc43495f")
+
+	ra, err := m.ResolutionAdvisory()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	exp := ResolutionAdvisory{
+		ARA:        0x2400,
+		RAC:        0x2,
+		MTE:        true,
+		TTI:        adsbtype.TTI1,
+		ThreatICAO: 0xabcdef,
+	}
+
+	if ra != exp {
+		t.Errorf("received %+v, expected %+v", ra, exp)
+	}
+
+	_, err = ra.ThreatAlt()
+	if !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("expected %s, received %v", ErrNotAvailable, err)
+	}
+
+	_, err = m.Sqk()
+	if !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("expected %s, received %v", ErrNotAvailable, err)
+	}
+}
+
+func testRAThreatPosition(t *testing.T) {
+	m := testHexMsg(t, "8da80287e2800028d70550335a7f")
+
+	ra, err := m.ResolutionAdvisory()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	if ra.ARA != 0x2000 || !ra.RAT || ra.MTE || ra.TTI != adsbtype.TTI2 || ra.ThreatICAO != 0 {
+		t.Errorf("received unexpected advisory %+v", ra)
+	}
+
+	alt, err := ra.ThreatAlt()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	if alt != 10000 {
+		t.Errorf("ThreatAlt: received %d, expected 10000", alt)
+	}
+
+	rng, err := ra.ThreatRange()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	if rng != 2 {
+		t.Errorf("ThreatRange: received %f, expected 2", rng)
+	}
+
+	brg, err := ra.ThreatBearing()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	if brg != 90 {
+		t.Errorf("ThreatBearing: received %f, expected 90", brg)
+	}
+}
+
+func testRAErrors(t *testing.T) {
+	m := testHexMsg(t, "8dacf84e23101332cf3ca037ef13")
+
+	_, err := m.ResolutionAdvisory()
+	if err == nil || err.Error() != "error retrieving resolution advisory: type code 4: field not available" {
+		t.Errorf("received unexpected error %v", err)
+	}
+
+	_, err = m.Emergency()
+	if !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("expected %s, received %v", ErrNotAvailable, err)
+	}
+
+	// short messages without an ME, MV or MB field
+	for _, msg := range []string{
+		"20001910bc45e9", // DF4
+		"5daa234a912889", // DF11
+	} {
+		_, err = testHexMsg(t, msg).ResolutionAdvisory()
+		if !errors.Is(err, ErrNotAvailable) {
+			t.Errorf("%s: expected %s, received %v", msg, ErrNotAvailable, err)
+		}
+	}
+
+	ra := ResolutionAdvisory{TTI: adsbtype.TTI2}
+
+	_, err = ra.ThreatRange()
+	if !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("expected %s, received %v", ErrNotAvailable, err)
+	}
+
+	_, err = ra.ThreatBearing()
+	if !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("expected %s, received %v", ErrNotAvailable, err)
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"math"
+
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+// InferenceHint is the known ADS-B state of an aircraft, used by
+// InferBDS to choose between registers 5,0 and 6,0.
+type InferenceHint struct {
+	GroundSpeed float64 // ground speed in m/s
+	Track       float64 // track angle in degrees
+	Alt         int64   // barometric altitude in feet, 0 if unknown
+}
+
+// inferOrder is the order in which candidate registers are tested.
+// Registers which carry their own BDS number are listed first, as a
+// match is more reliable.
+var inferOrder = []adsbtype.BDS{
+	adsbtype.BDS10,
+	adsbtype.BDS20,
+	adsbtype.BDS30,
+	adsbtype.BDS17,
+	adsbtype.BDS40,
+	adsbtype.BDS50,
+	adsbtype.BDS60,
+	adsbtype.BDS44,
+	adsbtype.BDS45,
+}
+
+// InferBDS returns the registers which the MB field of a Comm-B reply
+// may contain, most likely first. If h is not nil, it is used to rank
+// registers 5,0 and 6,0 by how closely they agree with the aircraft's
+// ADS-B ground speed and track.
+func InferBDS(mb uint64, h *InferenceHint) []adsbtype.BDS {
+	c := CommB(mb)
+
+	var res []adsbtype.BDS
+
+	for _, bds := range inferOrder {
+		if c.is(bds) == nil {
+			res = append(res, bds)
+		}
+	}
+
+	if h == nil {
+		return res
+	}
+
+	t, err50 := c.BDS50()
+	s, err60 := c.BDS60()
+
+	if err50 != nil || err60 != nil {
+		return res
+	}
+
+	spd, ok := s.IAS, s.IASValid
+	if s.MachValid && h.Alt != 0 {
+		spd, ok = machToTAS(s.Mach, h.Alt), true
+	}
+
+	s50 := hintScore(h, t.Track, t.TrackValid, t.GroundSpeed, t.GroundSpeedValid)
+	s60 := hintScore(h, s.Heading, s.HeadingValid, spd, ok)
+
+	// 5,0 is tested first, so swap the two if 6,0 agrees better
+	if s60 < s50 {
+		for i, bds := range res {
+			switch bds {
+			case adsbtype.BDS50:
+				res[i] = adsbtype.BDS60
+			case adsbtype.BDS60:
+				res[i] = adsbtype.BDS50
+			}
+		}
+	}
+
+	return res
+}
+
+// is returns an error if the MB field is not consistent with register
+// bds.
+func (c CommB) is(bds adsbtype.BDS) error {
+	var err error
+
+	switch bds {
+	case adsbtype.BDS10:
+		_, err = c.BDS10()
+	case adsbtype.BDS17:
+		_, err = c.BDS17()
+	case adsbtype.BDS20:
+		err = c.isBDS20()
+	case adsbtype.BDS30:
+		_, err = c.BDS30()
+	case adsbtype.BDS40:
+		_, err = c.BDS40()
+	case adsbtype.BDS44:
+		_, err = c.BDS44()
+	case adsbtype.BDS45:
+		_, err = c.BDS45()
+	case adsbtype.BDS50:
+		_, err = c.BDS50()
+	case adsbtype.BDS60:
+		_, err = c.BDS60()
+	default:
+		err = newErrorf(ErrNotAvailable, "register %s", bds)
+	}
+
+	return err
+}
+
+// isBDS20 checks the content of an aircraft identification report.
+func (c CommB) isBDS20() error {
+	if c.bits(1, 8) != 0x20 {
+		return newError(ErrInvalidRegister, "BDS mismatch")
+	}
+
+	if c.bits(9, 56) == 0x820820820820 { // all spaces
+		return newError(ErrInvalidRegister, "empty identification")
+	}
+
+	for i := 9; i < 56; i += 6 {
+		if callChars[c.bits(i, i+5)] == '?' {
+			return newError(ErrInvalidRegister, "invalid character")
+		}
+	}
+
+	return nil
+}
+
+// hintScore returns how far an angle and speed differ from h. One
+// degree is treated as equivalent to one m/s. Missing values are
+// scored as a large difference.
+func hintScore(h *InferenceHint, ang float64, angOK bool, spd float64, spdOK bool) float64 {
+	const missing = 180
+
+	score := float64(missing * 2)
+
+	if angOK {
+		d := math.Mod(math.Abs(ang-h.Track), 360)
+		score += math.Min(d, 360-d) - missing
+	}
+
+	if spdOK {
+		score += math.Abs(spd-h.GroundSpeed) - missing
+	}
+
+	return score
+}
+
+// machToTAS converts a Mach number to true airspeed in m/s, using the
+// ISA temperature at altitude alt in feet.
+func machToTAS(mach float64, alt int64) float64 {
+	const (
+		seaLevelTemp   = 288.15    // K
+		tropopauseTemp = 216.65    // K
+		lapseRate      = 0.0019812 // K per foot
+		seaLevelSound  = 340.294   // m/s
+	)
+
+	t := math.Max(seaLevelTemp-lapseRate*float64(alt), tropopauseTemp)
+
+	return mach * seaLevelSound * math.Sqrt(t/seaLevelTemp)
+}
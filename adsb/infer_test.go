@@ -0,0 +1,103 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+func TestInferBDS(t *testing.T) {
+	for _, tc := range []struct {
+		Name string
+		Msg  string
+		Exp  []adsbtype.BDS
+	}{
+		{"BDS10", "a800178d10010080f50000d5893c", []adsbtype.BDS{adsbtype.BDS10}},
+		{"BDS17", "a0000638fa81c10000000081a92f", []adsbtype.BDS{adsbtype.BDS17, adsbtype.BDS45}},
+		{"BDS20", "a000083e202cc371c31de0aa1ccf", []adsbtype.BDS{adsbtype.BDS20}},
+		{"BDS30", "a00000003088009987064b000000", []adsbtype.BDS{adsbtype.BDS30}},
+		{"BDS40", "a000029c85e42f313000007047d3", []adsbtype.BDS{adsbtype.BDS40}},
+		{"BDS44", "a0001692185bd5cf400000dfc696", []adsbtype.BDS{adsbtype.BDS44}},
+		{"BDS45", "a0000000a061ec23ea0c80000000", []adsbtype.BDS{adsbtype.BDS45}},
+		{"BDS50", "a000139381951536e024d4ccf6b5", []adsbtype.BDS{adsbtype.BDS50}},
+		{"BDS60", "a00004128f39f91a7e27c46adc21", []adsbtype.BDS{adsbtype.BDS60}},
+		{"None", "a0000691e8d9df4eb9a57fb93ae7", nil},
+		{"Empty", "a000000000000000000000000000", nil},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			res := InferBDS(uint64(testCommB(t, tc.Msg)), nil)
+			if !reflect.DeepEqual(res, tc.Exp) {
+				t.Errorf("received %v, expected %v", res, tc.Exp)
+			}
+		})
+	}
+}
+
+func TestInferBDSHint(t *testing.T) {
+	// consistent with both 5,0 and 6,0
+	const mb = 0x86bb5b27a4b470
+
+	for _, tc := range []struct {
+		Name string
+		Hint *InferenceHint
+		Exp  []adsbtype.BDS
+	}{
+		{"NoHint", nil,
+			[]adsbtype.BDS{adsbtype.BDS50, adsbtype.BDS60}},
+		{"TrackTurn", &InferenceHint{GroundSpeed: 160, Track: 254},
+			[]adsbtype.BDS{adsbtype.BDS50, adsbtype.BDS60}},
+		{"HeadingSpeed", &InferenceHint{GroundSpeed: 190, Track: 15, Alt: 35000},
+			[]adsbtype.BDS{adsbtype.BDS60, adsbtype.BDS50}},
+		{"HeadingSpeedIAS", &InferenceHint{GroundSpeed: 220, Track: 20},
+			[]adsbtype.BDS{adsbtype.BDS60, adsbtype.BDS50}},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			res := InferBDS(mb, tc.Hint)
+			if !reflect.DeepEqual(res, tc.Exp) {
+				t.Errorf("received %v, expected %v", res, tc.Exp)
+			}
+		})
+	}
+}
+
+func TestMachToTAS(t *testing.T) {
+	for _, tc := range []struct {
+		Alt int64
+		Exp float64
+	}{
+		{0, 340.294},
+		{36089, 295.07},
+		{40000, 295.07},
+	} {
+		if res := machToTAS(1, tc.Alt); res < tc.Exp-0.1 || res > tc.Exp+0.1 {
+			t.Errorf("%d: received %f, expected %f", tc.Alt, res, tc.Exp)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"math"
+
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+// Integrity is the integrity of a position report. For ADS-B version 0
+// the navigation uncertainty category (NUCp) is reported, for later
+// versions the navigation integrity category (NIC).
+type Integrity struct {
+	Version adsbtype.VER
+	NUCp    uint8   // version 0 only
+	NIC     uint8   // version 1 and later
+	Rc      float64 // containment radius in meters, +Inf if unknown
+}
+
+// nucTbl maps a position type code to NUCp.
+var nucTbl = map[uint64]uint8{
+	0: 0, 5: 9, 6: 8, 7: 7, 8: 6,
+	9: 9, 10: 8, 11: 7, 12: 6, 13: 5, 14: 4, 15: 3, 16: 2, 17: 1, 18: 0,
+	20: 9, 21: 8, 22: 0,
+}
+
+// nucRcTbl is the horizontal protection limit in meters for each NUCp.
+var nucRcTbl = []float64{
+	math.Inf(1), 37040, 18520, 3704, 1852, 926, 370.4, 185.2, 25, 7.5,
+}
+
+// nicEntry is a NIC and containment radius in meters.
+type nicEntry struct {
+	nic uint8
+	rc  float64
+}
+
+// nicKey selects a nicEntry by type code and NIC supplement bits. The
+// supplement bits are A and B for airborne positions, and A and C for
+// surface positions. Supplement bits which do not apply to a type code
+// are zero.
+type nicKey struct {
+	tc   uint64
+	a, x bool
+}
+
+// nicTbl maps a position type code and NIC supplements to the NIC and
+// containment radius for ADS-B version 2. Version 1 uses NIC supplement
+// A only.
+var nicTbl = map[nicKey]nicEntry{
+	{5, false, false}:  {11, 7.5},
+	{6, false, false}:  {10, 25},
+	{7, true, false}:   {9, 75},
+	{7, false, false}:  {8, 185.2},
+	{8, true, true}:    {7, 370.4},
+	{8, true, false}:   {6, 555.6},
+	{8, false, true}:   {6, 1111.2},
+	{8, false, false}:  {0, math.Inf(1)},
+	{9, false, false}:  {11, 7.5},
+	{10, false, false}: {10, 25},
+	{11, true, true}:   {9, 75},
+	{11, false, false}: {8, 185.2},
+	{12, false, false}: {7, 370.4},
+	{13, false, true}:  {6, 555.6},
+	{13, false, false}: {6, 926},
+	{13, true, true}:   {6, 1111.2},
+	{14, false, false}: {5, 1852},
+	{15, false, false}: {4, 3704},
+	{16, true, true}:   {3, 7408},
+	{16, false, false}: {2, 14816},
+	{17, false, false}: {1, 37040},
+	{18, false, false}: {0, math.Inf(1)},
+	{20, false, false}: {11, 7.5},
+	{21, false, false}: {10, 25},
+	{22, false, false}: {0, math.Inf(1)},
+	{0, false, false}:  {0, math.Inf(1)},
+}
+
+// nicV1Tbl is the version 1 equivalent of nicTbl for the type codes
+// where it differs. Only NIC supplement A applies.
+var nicV1Tbl = map[nicKey]nicEntry{
+	{8, false, false}:  {0, math.Inf(1)},
+	{8, true, false}:   {0, math.Inf(1)},
+	{11, true, false}:  {9, 75},
+	{13, true, false}:  {6, 1111.2},
+	{16, true, false}:  {3, 7408},
+	{16, false, false}: {2, 14816},
+}
+
+// nicUsesA lists the type codes whose NIC depends on supplement A in
+// version 2.
+var nicUsesA = map[uint64]bool{7: true, 8: true, 11: true, 13: true, 16: true}
+
+// nicUsesX lists the type codes whose NIC depends on supplement B or C
+// in version 2.
+var nicUsesX = map[uint64]bool{8: true, 11: true, 13: true, 16: true}
+
+// Integrity returns the integrity of a position report, given the ADS-B
+// version and the NIC supplements A and C from the aircraft operational
+// status. NIC supplement B is taken from the position report.
+func (m *Message) Integrity(v adsbtype.VER, nicA, nicC bool) (Integrity, error) {
+	tc, err := m.raw.ESType()
+	if err != nil {
+		return Integrity{}, newError(err, "error retrieving integrity")
+	}
+
+	if _, ok := nucTbl[tc]; !ok {
+		return Integrity{}, newErrorf(ErrNotAvailable, "error retrieving integrity from %d", tc)
+	}
+
+	i := Integrity{Version: v}
+
+	if v == adsbtype.VER0 {
+		i.NUCp = nucTbl[tc]
+		i.Rc = nucRcTbl[i.NUCp]
+
+		return i, nil
+	}
+
+	// NIC supplement B is carried in airborne positions, and C in the
+	// operational status of surface aircraft
+	x := nicC
+	if tc >= 9 {
+		x = m.raw.Bit(40) == 1
+	}
+
+	k := nicKey{tc: tc, a: nicA && nicUsesA[tc], x: x && nicUsesX[tc]}
+
+	var e nicEntry
+
+	var ok bool
+
+	if v == adsbtype.VER1 {
+		k.x = false
+		e, ok = nicV1Tbl[k]
+	}
+
+	if !ok {
+		e, ok = nicTbl[k]
+	}
+
+	if !ok {
+		return Integrity{}, newErrorf(ErrNotAvailable, "invalid NIC supplements for %d", tc)
+	}
+
+	i.NIC = e.nic
+	i.Rc = e.rc
+
+	return i, nil
+}
+
+// Integrity returns the integrity of the position report m, using the
+// version and NIC supplements in s.
+func (s OperationalStatus) Integrity(m *Message) (Integrity, error) {
+	return m.Integrity(s.Version, s.NICSuppA, s.NICSuppC)
+}
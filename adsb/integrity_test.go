@@ -0,0 +1,104 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+func TestIntegrity(t *testing.T) {
+	inf := math.Inf(1)
+
+	for _, tc := range []struct {
+		Name    string
+		Msg     string
+		Version adsbtype.VER
+		NICA    bool
+		NICC    bool
+		NUCp    uint8
+		NIC     uint8
+		Rc      float64
+	}{
+		{"V0TC11", "8da8028758ab0028de078689d437", adsbtype.VER0, true, false, 7, 0, 185.2},
+		{"V0TC12", "8da9450d60bde138e8638c939134", adsbtype.VER0, false, false, 6, 0, 370.4},
+		{"V0Surface", "8c4841753aab238733c8cd4020b1", adsbtype.VER0, false, false, 7, 0, 185.2},
+		{"V1TC11A", "8da8028758ab0028de078689d437", adsbtype.VER1, true, false, 0, 9, 75},
+		{"V1TC11", "8da8028758ab0028de078689d437", adsbtype.VER1, false, false, 0, 8, 185.2},
+		{"V1TC11B", "8da8028759ab0028de078655aec0", adsbtype.VER1, true, false, 0, 9, 75},
+		{"V2TC11", "8da8028758ab0028de078689d437", adsbtype.VER2, false, false, 0, 8, 185.2},
+		{"V2TC11AB", "8da8028759ab0028de078655aec0", adsbtype.VER2, true, false, 0, 9, 75},
+		{"V2TC12", "8da9450d60bde138e8638c939134", adsbtype.VER2, true, true, 0, 7, 370.4},
+		{"V2TC20", "8da80287a07fa028de0786469665", adsbtype.VER2, false, false, 0, 11, 7.5},
+		{"V2Surface7A", "8c4841753aab238733c8cd4020b1", adsbtype.VER2, true, false, 0, 9, 75},
+		{"V2Surface8AC", "8c48417542ab238733c8cd0baacd", adsbtype.VER2, true, true, 0, 7, 370.4},
+		{"V2Surface8C", "8c48417542ab238733c8cd0baacd", adsbtype.VER2, false, true, 0, 6, 1111.2},
+		{"V2Surface8", "8c48417542ab238733c8cd0baacd", adsbtype.VER2, false, false, 0, 0, inf},
+		{"V1Surface8C", "8c48417542ab238733c8cd0baacd", adsbtype.VER1, true, true, 0, 0, inf},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			m := testHexMsg(t, tc.Msg)
+
+			i, err := OperationalStatus{Version: tc.Version, NICSuppA: tc.NICA, NICSuppC: tc.NICC}.Integrity(m)
+			if err != nil {
+				t.Fatal("received unexpected error:", err)
+			}
+
+			if i.Version != tc.Version || i.NUCp != tc.NUCp || i.NIC != tc.NIC || i.Rc != tc.Rc {
+				t.Errorf("received %+v, expected NUCp %d NIC %d Rc %f", i, tc.NUCp, tc.NIC, tc.Rc)
+			}
+		})
+	}
+}
+
+func TestIntegrityErrors(t *testing.T) {
+	for _, tc := range []struct {
+		Msg     string
+		Version adsbtype.VER
+		NICA    bool
+		Error   string
+	}{
+		{"8dacf84e23101332cf3ca037ef13", adsbtype.VER2, false,
+			"error retrieving integrity from 4: field not available"},
+		{"20001910bc45e9", adsbtype.VER2, false,
+			"error retrieving integrity: error retrieving ESType from 4: field not available"},
+		{"8da8028758ab0028de078689d437", adsbtype.VER2, true,
+			"invalid NIC supplements for 11: field not available"},
+	} {
+		m := testHexMsg(t, tc.Msg)
+
+		_, err := m.Integrity(tc.Version, tc.NICA, false)
+		if err == nil || err.Error() != tc.Error {
+			t.Errorf("expected %s, received %v", tc.Error, err)
+		}
+
+		if !errors.Is(err, ErrNotAvailable) {
+			t.Errorf("expected %s, received %v", ErrNotAvailable, err)
+		}
+	}
+}
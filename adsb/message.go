@@ -42,6 +42,7 @@ type Message struct {
 const (
 	KNOT_TO_MPS         = 0.514444444 // factor to convert from knot to m/s
 	FEET_PER_MIN_TO_MPS = 0.00508     // factor to convert from feet/min to m/s
+	FEET_TO_METER       = 0.3048      // factor to convert from feet to m
 )
 
 // NewMessage wraps a RawMessage and returns the new Message.
@@ -118,33 +119,90 @@ func (m *Message) ICAO() (uint64, error) {
 	return ap ^ m.raw.Parity(), nil
 }
 
-// Alt returns the altitude.
+// Alt returns the altitude in feet.
 func (m *Message) Alt() (int64, error) {
+	_, ft, _, err := m.BaroAlt()
+
+	return ft, err
+}
+
+// BaroAlt returns the barometric altitude in both meters and feet,
+// and the unit in which it was reported. Extended squitter altitudes are
+// always reported in feet.
+func (m *Message) BaroAlt() (meters int64, feet int64, unit adsbtype.M, err error) {
 	df, err := m.raw.DF()
 	if err != nil {
-		return 0, newError(err, "error retrieving altitude")
+		return 0, 0, 0, newError(err, "error retrieving altitude")
 	}
 
 	switch df {
 	case 0, 4, 16, 20:
 		ac, err := m.raw.AC()
 		if err != nil {
-			return 0, newError(err, "error retrieving altitude")
+			return 0, 0, 0, newError(err, "error retrieving altitude")
 		}
 
-		return decodeAC(ac)
+		return decodeACUnit(ac)
 	case 17, 18:
 		alt, err := m.raw.ESAltitude()
 		if err != nil {
-			return 0, newError(err, "error retrieving altitude")
+			return 0, 0, 0, newError(err, "error retrieving altitude")
+		}
+
+		ft, err := decodeESAlt(alt)
+		if err != nil {
+			return 0, 0, 0, err
 		}
 
-		return decodeESAlt(alt)
+		return int64(math.Round(float64(ft) * FEET_TO_METER)), ft, adsbtype.M0, nil
 	default:
-		return 0, newError(ErrNotAvailable, "error retrieving altitude")
+		return 0, 0, 0, newError(ErrNotAvailable, "error retrieving altitude")
 	}
 }
 
+// AltType returns whether Alt or GeometricAlt provides the altitude.
+func (m *Message) AltType() (adsbtype.ATS, error) {
+	df, err := m.raw.DF()
+	if err != nil {
+		return 0, newError(err, "error retrieving altitude type")
+	}
+
+	switch df {
+	case 0, 4, 16, 20:
+		return adsbtype.ATS0, nil
+	case 17, 18:
+		tc, err := m.raw.ESType()
+		if err != nil {
+			return 0, newError(err, "error retrieving altitude type")
+		}
+
+		switch {
+		case tc == 0, tc >= 9 && tc <= 18:
+			return adsbtype.ATS0, nil
+		case tc >= 20 && tc <= 22:
+			return adsbtype.ATS1, nil
+		}
+	}
+
+	return 0, newError(ErrNotAvailable, "error retrieving altitude type")
+}
+
+// GeometricAlt returns the GNSS height from an airborne position with
+// type code 20 to 22. The height is encoded in meters, and is also
+// returned converted to feet.
+func (m *Message) GeometricAlt() (meters int64, feet int64, err error) {
+	h, err := m.raw.ESHeight()
+	if err != nil {
+		return 0, 0, newError(err, "error retrieving geometric altitude")
+	}
+
+	if h == 0 {
+		return 0, 0, newError(nil, "invalid altitude data")
+	}
+
+	return int64(h), int64(math.Round(float64(h) / FEET_TO_METER)), nil
+}
+
 var callChars = []byte(
 	"?ABCDEFGHIJKLMNOPQRSTUVWXYZ????? ???????????????0123456789??????")
 
@@ -188,7 +246,8 @@ var sqkTbl = [][]int{
 	{32, 30, 28},
 }
 
-// Sqk returns the squawk code.
+// Sqk returns the squawk code. In extended squitter messages, the
+// squawk code is only available from an emergency status message.
 func (m *Message) Sqk() ([]byte, error) {
 	sqk := make([]byte, 0, 4)
 
@@ -197,8 +256,21 @@ func (m *Message) Sqk() ([]byte, error) {
 		return nil, newError(err, "error retrieving squawk")
 	}
 
+	var offset int
+
 	switch df {
 	case 5, 21:
+	case 17, 18:
+		tc, err := m.raw.ESType()
+		if err != nil {
+			return nil, newError(err, "error retrieving squawk")
+		}
+
+		if tc != 28 || m.raw.Bits(38, 40) != 1 {
+			return nil, newError(ErrNotAvailable, "error retrieving squawk")
+		}
+
+		offset = 24 // Mode A code follows the emergency state
 	default:
 		return nil, newError(ErrNotAvailable, "error retrieving squawk")
 	}
@@ -208,7 +280,7 @@ func (m *Message) Sqk() ([]byte, error) {
 	for i, v := range sqkTbl {
 		for _, x := range v {
 			sqk[i] <<= 1
-			sqk[i] |= m.raw.Bit(x)
+			sqk[i] |= m.raw.Bit(x + offset)
 		}
 	}
 
@@ -231,7 +303,7 @@ func (m *Message) CPR() (*CPR, bool, error) {
 			return nil, false, newError(err, "error retrieving position")
 		}
 
-		if (tc < 9 || tc > 18) && (tc < 5 || tc > 8) {
+		if (tc < 5 || tc > 18) && (tc < 20 || tc > 22) {
 			return nil, false, newError(ErrNotAvailable, "error retrieving position")
 		}
 	default:
@@ -245,7 +317,7 @@ func (m *Message) CPR() (*CPR, bool, error) {
 	c.Lat = uint32(m.raw.Bits(55, 71))
 	c.Lon = uint32(m.raw.Bits(72, 88))
 
-	isAirborne := typeCode >= 9 && typeCode <= 18
+	isAirborne := typeCode >= 9 && typeCode != 19
 	return c, isAirborne, nil
 }
 
@@ -369,11 +369,13 @@ func TestDecode(t *testing.T) {
 	t.Run("DF0", testDF0)
 	t.Run("DF4", testDF4A)
 	t.Run("DF4 Gillham", testDF4B)
+	t.Run("DF4 Metric", testAltMetric)
 	t.Run("DF5", testDF5)
 	t.Run("DF11", testDF11)
 	t.Run("DF17 Position Local", testDF17PosLocal)
 	t.Run("DF17 Position Global", testDF17PosGlobal)
 	t.Run("DF17 Position Global Reverse", testDF17PosGlobalRev)
+	t.Run("DF17 Position GNSS", testDF17PosGNSS)
 	t.Run("DF17 Identity", testDF17Ident)
 	t.Run("DF20", testDF20)
 	t.Run("DF21", testDF21)
@@ -382,7 +384,6 @@ func TestDecode(t *testing.T) {
 
 // TestDecodeErrors runs test cases for message decoding errors.
 func TestDecodeErrors(t *testing.T) {
-	t.Run("MetricAltitude", testAltErrMetric)
 	t.Run("InvalidAltitude", testAltErrInvalid)
 }
 
@@ -675,6 +676,36 @@ func testDF17PosGlobalRev(t *testing.T) {
 	testDecode(t, tc)
 }
 
+// test DF17 extended squitter position with GNSS height, global decode.
+func testDF17PosGNSS(t *testing.T) {
+	tc := &testCase{
+		Msg: "8da80287a07fa028de0786469665",
+
+		DF: 17,
+		CA: 5,
+		FS: -1,
+		VS: -1,
+
+		TC:  20,
+		SS:  0,
+		Cat: "",
+
+		CPR:       true,
+		GlobalPos: true,
+		Msg2:      "8da80287a87fd7b0b8876e3e1c42",
+
+		Lat: 42.23945229,
+		Lon: -89.87851165,
+
+		ICAO: 0xa80287,
+		Alt:  0,
+		Sqk:  []byte{},
+		Call: "",
+	}
+
+	testDecode(t, tc)
+}
+
 // test DF17 extended squitter identity.
 func testDF17Ident(t *testing.T) {
 	tc := &testCase{
@@ -886,14 +917,23 @@ func testAlt(t *testing.T, tc *testCase, msg *Message) {
 }
 
 // test DF4 with metric altitude.
-func testAltErrMetric(t *testing.T) {
-	tc := &testCase{
-		Msg: "2000046210fc86",
+func testAltMetric(t *testing.T) {
+	m := testHexMsg(t, "2000046210fc86")
 
-		AltError: "metric altitude not supported",
+	meters, feet, unit, err := m.BaroAlt()
+	if err != nil {
+		t.Fatal("received unexpected error", err)
 	}
 
-	testDecodeErr(t, tc)
+	if meters != 546 || feet != 1791 || unit != adsbtype.M1 {
+		t.Errorf("received %d m %d ft %s, expected 546 m 1791 ft %s",
+			meters, feet, unit, adsbtype.M1)
+	}
+
+	alt, err := m.Alt()
+	if err != nil || alt != 1791 {
+		t.Errorf("Alt: received %d, %v, expected 1791", alt, err)
+	}
 }
 
 // test DF4 with invalid altitude.
@@ -927,6 +967,25 @@ func testDecodeErr(t *testing.T, tc *testCase) {
 	}
 }
 
+// testHexMsg returns the Message decoded from the hex string s.
+func testHexMsg(t *testing.T, s string) *Message {
+	t.Helper()
+
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	m := new(Message)
+
+	err = m.UnmarshalBinary(b)
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	return m
+}
+
 func testAltError(t *testing.T, tc *testCase, msg *Message) {
 	t.Helper()
 
@@ -946,6 +1005,63 @@ func testAltError(t *testing.T, tc *testCase, msg *Message) {
 	}
 }
 
+func TestGeometricAlt(t *testing.T) {
+	for _, tc := range []struct {
+		Name    string
+		Msg     string
+		AltType adsbtype.ATS
+		Meters  int64
+		Feet    int64
+		Error   string
+	}{
+		{"TC20", "8da80287a07fa028de0786469665", adsbtype.ATS1, 2042, 6699, ""},
+		{"TC21", "8da80287a87fd7b0b8876e3e1c42", adsbtype.ATS1, 2045, 6709, ""},
+		{"Barometric", "8da8028758ab0028de078689d437", adsbtype.ATS0, 0, 0,
+			"error retrieving geometric altitude: error retrieving ESHeight from 11: field not available"},
+		{"DF4", "20001910bc45e9", adsbtype.ATS0, 0, 0,
+			"error retrieving geometric altitude: error retrieving ESType from 4: field not available"},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			b, err := hex.DecodeString(tc.Msg)
+			if err != nil {
+				t.Fatal("received unexpected error", err)
+			}
+
+			msg := new(Message)
+
+			err = msg.UnmarshalBinary(b)
+			if err != nil {
+				t.Fatal("received unexpected error", err)
+			}
+
+			at, err := msg.AltType()
+			if err != nil {
+				t.Fatal("received unexpected error", err)
+			}
+
+			if at != tc.AltType {
+				t.Errorf("AltType: received %s, expected %s", at, tc.AltType)
+			}
+
+			m, f, err := msg.GeometricAlt()
+			if tc.Error != "" {
+				if err == nil || err.Error() != tc.Error {
+					t.Errorf("expected %s, received %v", tc.Error, err)
+				}
+			} else if err != nil {
+				t.Fatal("received unexpected error", err)
+			}
+
+			if m != tc.Meters || f != tc.Feet {
+				t.Errorf("GeometricAlt: received %d m %d ft, expected %d m %d ft",
+					m, f, tc.Meters, tc.Feet)
+			}
+		})
+	}
+}
+
 func testGroundSpeed(t *testing.T) {
 	b, err := hex.DecodeString("8dc054bd9908dc85986c0c2ebe76")
 	if err != nil {
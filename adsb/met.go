@@ -0,0 +1,254 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"math"
+
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+// MetRoutine is the content of Comm-B register 4,4, meteorological
+// routine air report. Each value is only meaningful if its
+// corresponding Valid field is true.
+type MetRoutine struct {
+	FOM adsbtype.FOM // source of the wind data
+
+	WindSpeed     float64 // wind speed in m/s
+	WindDirection float64 // direction the wind blows from, degrees true [0, 360)
+	WindValid     bool
+
+	Temp float64 // static air temperature in degrees Celsius
+
+	Pressure      float64 // average static pressure in hPa
+	PressureValid bool
+
+	Turbulence      adsbtype.HZL
+	TurbulenceValid bool
+
+	Humidity      float64 // relative humidity in percent
+	HumidityValid bool
+}
+
+// MetHazard is the content of Comm-B register 4,5, meteorological
+// hazard report. Each value is only meaningful if its corresponding
+// Valid field is true.
+type MetHazard struct {
+	Turbulence      adsbtype.HZL
+	TurbulenceValid bool
+
+	WindShear      adsbtype.HZL
+	WindShearValid bool
+
+	Microburst      adsbtype.HZL
+	MicroburstValid bool
+
+	Icing      adsbtype.HZL
+	IcingValid bool
+
+	WakeVortex      adsbtype.HZL
+	WakeVortexValid bool
+
+	Temp      float64 // static air temperature in degrees Celsius
+	TempValid bool
+
+	Pressure      float64 // average static pressure in hPa
+	PressureValid bool
+
+	RadioHeight      int64 // radio height in feet
+	RadioHeightValid bool
+}
+
+// Limits used to check meteorological registers.
+const (
+	maxWindSpeed = 250 // knots
+	minTemp      = -80 // degrees Celsius
+	maxTemp      = 60  // degrees Celsius
+)
+
+// BDS44 decodes the MB field as register 4,4, meteorological routine
+// air report.
+func (c CommB) BDS44() (MetRoutine, error) {
+	var r MetRoutine
+
+	err := c.check(
+		c.status(5, 6, 23),
+		c.status(35, 36, 46),
+		c.status(47, 48, 49),
+		c.status(50, 51, 56),
+	)
+	if err != nil {
+		return MetRoutine{}, newError(err, "error decoding BDS 4,4")
+	}
+
+	r.FOM = adsbtype.FOM(c.bits(1, 4))
+
+	ws := c.bits(6, 14)
+	if r.WindValid = c.bit(5); r.WindValid {
+		r.WindSpeed = float64(ws) * KNOT_TO_MPS
+		r.WindDirection = float64(c.bits(15, 23)) * 180 / 256
+	}
+
+	r.Temp = float64(c.signed(24, 34)) * 0.25
+
+	if r.PressureValid = c.bit(35); r.PressureValid {
+		r.Pressure = float64(c.bits(36, 46))
+	}
+
+	if r.TurbulenceValid = c.bit(47); r.TurbulenceValid {
+		r.Turbulence = adsbtype.HZL(c.bits(48, 49))
+	}
+
+	if r.HumidityValid = c.bit(50); r.HumidityValid {
+		r.Humidity = float64(c.bits(51, 56)) * 100 / 64
+	}
+
+	switch {
+	case r.FOM > adsbtype.FOM4:
+		err = newError(ErrInvalidRegister, "invalid figure of merit")
+	case ws > maxWindSpeed:
+		err = newError(ErrInvalidRegister, "wind speed out of range")
+	case r.Temp < minTemp || r.Temp > maxTemp:
+		err = newError(ErrInvalidRegister, "temperature out of range")
+	}
+
+	if err != nil {
+		return MetRoutine{}, newError(err, "error decoding BDS 4,4")
+	}
+
+	return r, nil
+}
+
+// BDS45 decodes the MB field as register 4,5, meteorological hazard
+// report.
+func (c CommB) BDS45() (MetHazard, error) {
+	var h MetHazard
+
+	err := c.check(
+		c.status(1, 2, 3),
+		c.status(4, 5, 6),
+		c.status(7, 8, 9),
+		c.status(10, 11, 12),
+		c.status(13, 14, 15),
+		c.status(16, 17, 26),
+		c.status(27, 28, 38),
+		c.status(39, 40, 51),
+		c.reserved(52, 56),
+	)
+	if err != nil {
+		return MetHazard{}, newError(err, "error decoding BDS 4,5")
+	}
+
+	if h.TurbulenceValid = c.bit(1); h.TurbulenceValid {
+		h.Turbulence = adsbtype.HZL(c.bits(2, 3))
+	}
+
+	if h.WindShearValid = c.bit(4); h.WindShearValid {
+		h.WindShear = adsbtype.HZL(c.bits(5, 6))
+	}
+
+	if h.MicroburstValid = c.bit(7); h.MicroburstValid {
+		h.Microburst = adsbtype.HZL(c.bits(8, 9))
+	}
+
+	if h.IcingValid = c.bit(10); h.IcingValid {
+		h.Icing = adsbtype.HZL(c.bits(11, 12))
+	}
+
+	if h.WakeVortexValid = c.bit(13); h.WakeVortexValid {
+		h.WakeVortex = adsbtype.HZL(c.bits(14, 15))
+	}
+
+	if h.TempValid = c.bit(16); h.TempValid {
+		h.Temp = float64(c.signed(17, 26)) * 0.25
+	}
+
+	if h.PressureValid = c.bit(27); h.PressureValid {
+		h.Pressure = float64(c.bits(28, 38))
+	}
+
+	if h.RadioHeightValid = c.bit(39); h.RadioHeightValid {
+		h.RadioHeight = int64(c.bits(40, 51)) * 16
+	}
+
+	if h.Temp < minTemp || h.Temp > maxTemp {
+		return MetHazard{}, newError(
+			newError(ErrInvalidRegister, "temperature out of range"),
+			"error decoding BDS 4,5")
+	}
+
+	return h, nil
+}
+
+// Weather is the wind and temperature derived from the airspeed and
+// heading of an aircraft and its ADS-B ground velocity. Temp is only
+// meaningful if TempValid is true.
+type Weather struct {
+	WindSpeed     float64 // wind speed in m/s
+	WindDirection float64 // direction the wind blows from, degrees true [0, 360)
+
+	Temp      float64 // static air temperature in degrees Celsius
+	TempValid bool
+}
+
+// DeriveWeather returns the wind and temperature implied by registers
+// 5,0 and 6,0 of an aircraft and its ADS-B ground speed gs in m/s and
+// track trk in degrees. Register 6,0 reports magnetic heading, which is
+// corrected to true heading using the magnetic declination dec in
+// degrees, positive east.
+//
+// The wind is the difference between the ground and air velocity
+// vectors. The temperature is derived from the speed of sound implied
+// by the true airspeed and Mach number, and is only available if both
+// are valid.
+func DeriveWeather(t TrackTurn, h HeadingSpeed, gs float64, trk float64, dec float64) (Weather, error) {
+	if !t.TASValid || !h.HeadingValid {
+		return Weather{}, newError(ErrNotAvailable, "true airspeed and heading required")
+	}
+
+	hdg := (h.Heading + dec) * math.Pi / 180
+	gnd := trk * math.Pi / 180
+
+	e := gs*math.Sin(gnd) - t.TAS*math.Sin(hdg)
+	n := gs*math.Cos(gnd) - t.TAS*math.Cos(hdg)
+
+	var w Weather
+
+	w.WindSpeed = math.Hypot(e, n)
+	w.WindDirection = math.Mod(math.Atan2(-e, -n)*180/math.Pi+360, 360)
+
+	if h.MachValid && h.Mach > 0 {
+		const (
+			gamma  = 1.4     // ratio of specific heats of air
+			gasAir = 287.053 // specific gas constant of air, J/(kg K)
+			zeroC  = 273.15  // K
+		)
+
+		a := t.TAS / h.Mach
+
+		w.Temp = a*a/(gamma*gasAir) - zeroC
+		w.TempValid = true
+	}
+
+	return w, nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+func TestBDS44(t *testing.T) {
+	r, err := testCommB(t, "a0001692185bd5cf400000dfc696").BDS44()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	exp := MetRoutine{
+		FOM:           adsbtype.FOM1,
+		WindSpeed:     22 * KNOT_TO_MPS,
+		WindDirection: 344.53125,
+		WindValid:     true,
+		Temp:          -48.75,
+	}
+
+	if r != exp {
+		t.Errorf("received %+v, expected %+v", r, exp)
+	}
+}
+
+func TestBDS45(t *testing.T) {
+	h, err := testCommB(t, "a0000000a061ec23ea0c80000000").BDS45()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	exp := MetHazard{
+		Turbulence:       adsbtype.HZL1,
+		TurbulenceValid:  true,
+		Icing:            adsbtype.HZL2,
+		IcingValid:       true,
+		Temp:             -20,
+		TempValid:        true,
+		Pressure:         250,
+		PressureValid:    true,
+		RadioHeight:      1600,
+		RadioHeightValid: true,
+	}
+
+	if h != exp {
+		t.Errorf("received %+v, expected %+v", h, exp)
+	}
+}
+
+func TestMetErrors(t *testing.T) {
+	for _, tc := range []struct {
+		Name   string
+		Msg    string
+		Decode func(CommB) error
+		Error  string
+	}{
+		{"BDS44Status", "a0000000a061ec23ea0c80000000",
+			func(c CommB) error { _, err := c.BDS44(); return err },
+			"error decoding BDS 4,4: status bit 5 clear with data: invalid register content"},
+		{"BDS44FOM", "a000000090000000000000000000",
+			func(c CommB) error { _, err := c.BDS44(); return err },
+			"error decoding BDS 4,4: invalid figure of merit: invalid register content"},
+		{"BDS45Reserved", "a00000000000000000001f000000",
+			func(c CommB) error { _, err := c.BDS45(); return err },
+			"error decoding BDS 4,5: reserved bits 52-56 set: invalid register content"},
+		{"Empty", "a000000000000000000000000000",
+			func(c CommB) error { _, err := c.BDS45(); return err },
+			"error decoding BDS 4,5: no data: invalid register content"},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Decode(testCommB(t, tc.Msg))
+			if err == nil || err.Error() != tc.Error {
+				t.Errorf("expected %s, received %v", tc.Error, err)
+			}
+
+			if !errors.Is(err, ErrInvalidRegister) {
+				t.Errorf("expected %s, received %v", ErrInvalidRegister, err)
+			}
+		})
+	}
+}
+
+func TestDeriveWeather(t *testing.T) {
+	tt := TrackTurn{TAS: 200, TASValid: true}
+	hs := HeadingSpeed{Heading: 85, HeadingValid: true, Mach: 0.6, MachValid: true}
+
+	w, err := DeriveWeather(tt, hs, 210, 90, 5)
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	for _, v := range []struct {
+		Name string
+		Val  float64
+		Exp  float64
+	}{
+		{"WindSpeed", w.WindSpeed, 10},
+		{"WindDirection", w.WindDirection, 270},
+		{"Temp", w.Temp, 3.33},
+	} {
+		if math.Abs(v.Val-v.Exp) > 0.01 {
+			t.Errorf("%s: received %f, expected %f", v.Name, v.Val, v.Exp)
+		}
+	}
+
+	if !w.TempValid {
+		t.Error("expected valid temperature")
+	}
+
+	_, err = DeriveWeather(TrackTurn{}, hs, 210, 90, 0)
+	if !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("expected %s, received %v", ErrNotAvailable, err)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+// ModeAC is a Mode A or Mode C reply, as provided by a Beast type 1
+// frame. The two bytes of the reply hold one octal digit of the code in
+// each nibble, in the order A, B, C, D, with each digit in the lower
+// three bits (bit 4 being X, or SPI in the C nibble).
+//
+// A reply does not indicate whether it answers a Mode A or a Mode C
+// interrogation, so both Sqk and Alt may succeed for the same reply.
+type ModeAC struct {
+	code uint64
+	set  bool
+}
+
+// UnmarshalBinary implements the BinaryUnmarshaler interface, storing
+// a two byte Mode A/C reply.
+func (r *ModeAC) UnmarshalBinary(data []byte) error {
+	r.set = false
+
+	if len(data) != 2 {
+		return newErrorf(nil, "incorrect data length: %d bits with Mode A/C", len(data)*8)
+	}
+
+	r.code = uint64(data[0])<<8 | uint64(data[1])
+	r.set = true
+
+	return nil
+}
+
+// Sqk returns the squawk code, interpreting the reply as a Mode A
+// reply.
+func (r *ModeAC) Sqk() ([]byte, error) {
+	if !r.set {
+		return nil, newError(nil, "error retrieving squawk: no data loaded")
+	}
+
+	return []byte{
+		byte(r.code>>12) & 0x7,
+		byte(r.code>>8) & 0x7,
+		byte(r.code>>4) & 0x7,
+		byte(r.code) & 0x7,
+	}, nil
+}
+
+// SPI returns true if the special position identification pulse is
+// present.
+func (r *ModeAC) SPI() bool {
+	return r.set && r.code&0x0080 != 0
+}
+
+// Alt returns the altitude in feet, interpreting the reply as a Mode C
+// reply. An error is returned if the code is not a valid Gillham
+// encoded altitude.
+func (r *ModeAC) Alt() (int64, error) {
+	if !r.set {
+		return 0, newError(nil, "error retrieving altitude: no data loaded")
+	}
+
+	// X, SPI and D1 are never set in an altitude reply
+	if r.code&0x8889 != 0 {
+		return 0, newError(nil, "invalid altitude value")
+	}
+
+	bit := func(n uint) uint64 {
+		return (r.code >> n) & 1
+	}
+
+	// rearrange to the order of the Altitude Code field
+	ac := bit(4)<<12 | // C1
+		bit(12)<<11 | // A1
+		bit(5)<<10 | // C2
+		bit(13)<<9 | // A2
+		bit(6)<<8 | // C4
+		bit(14)<<7 | // A4
+		bit(8)<<5 | // B1
+		bit(9)<<3 | // B2
+		bit(1)<<2 | // D2
+		bit(10)<<1 | // B4
+		bit(2) // D4
+
+	return decodeAC(ac)
+}
@@ -0,0 +1,113 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"bytes"
+	"encoding"
+	"testing"
+)
+
+func TestModeACUnmarshalInterface(t *testing.T) {
+	var i interface{} = new(ModeAC)
+	if _, ok := i.(encoding.BinaryUnmarshaler); !ok {
+		t.Fatal("ModeAC does not implement encoding.BinaryUnmarshaler")
+	}
+}
+
+func TestModeAC(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Data     []byte
+		Sqk      []byte
+		SPI      bool
+		Alt      int64
+		AltError string
+	}{
+		{"Squawk", []byte{0x50, 0x47}, []byte{5, 0, 4, 7}, false, 0, "invalid altitude value"},
+		{"SPI", []byte{0x12, 0xb4}, []byte{1, 2, 3, 4}, true, 0, "invalid altitude value"},
+		{"Alt1300", []byte{0x07, 0x10}, []byte{0, 7, 1, 0}, false, 1300, ""},
+		{"AltNeg800", []byte{0x00, 0x10}, []byte{0, 0, 1, 0}, false, -800, ""},
+		{"NoC", []byte{0x07, 0x00}, []byte{0, 7, 0, 0}, false, 0, "invalid altitude value"},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			r := new(ModeAC)
+
+			err := r.UnmarshalBinary(tc.Data)
+			if err != nil {
+				t.Fatal("received unexpected error:", err)
+			}
+
+			sqk, err := r.Sqk()
+			if err != nil {
+				t.Fatal("received unexpected error:", err)
+			}
+
+			if !bytes.Equal(sqk, tc.Sqk) {
+				t.Errorf("Sqk: received %v, expected %v", sqk, tc.Sqk)
+			}
+
+			if r.SPI() != tc.SPI {
+				t.Errorf("SPI: received %t, expected %t", r.SPI(), tc.SPI)
+			}
+
+			alt, err := r.Alt()
+			if tc.AltError != "" {
+				if err == nil || err.Error() != tc.AltError {
+					t.Errorf("expected %s, received %v", tc.AltError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal("received unexpected error:", err)
+			}
+
+			if alt != tc.Alt {
+				t.Errorf("Alt: received %d, expected %d", alt, tc.Alt)
+			}
+		})
+	}
+}
+
+func TestModeACErrors(t *testing.T) {
+	r := new(ModeAC)
+
+	err := r.UnmarshalBinary([]byte{0x01, 0x02, 0x03})
+	if err == nil || err.Error() != "incorrect data length: 24 bits with Mode A/C" {
+		t.Errorf("received unexpected error: %v", err)
+	}
+
+	_, err = r.Sqk()
+	if err == nil || err.Error() != "error retrieving squawk: no data loaded" {
+		t.Errorf("received unexpected error: %v", err)
+	}
+
+	_, err = r.Alt()
+	if err == nil || err.Error() != "error retrieving altitude: no data loaded" {
+		t.Errorf("received unexpected error: %v", err)
+	}
+}
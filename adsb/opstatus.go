@@ -0,0 +1,115 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+// OperationalStatus is the content of an aircraft operational status
+// message, type code 31. Fields other than Subtype, CapabilityClass and
+// OperationalMode are only defined for ADS-B version 1 and later.
+type OperationalStatus struct {
+	Subtype adsbtype.OST
+	Version adsbtype.VER
+
+	CapabilityClass uint64 // 16 bits airborne, 12 bits surface
+	OperationalMode uint64 // 16 bits
+
+	NICSuppA bool  // NIC supplement A
+	NICSuppC bool  // NIC supplement C, surface version 2 only
+	NACp     uint8 // navigation accuracy category for position
+	GVA      uint8 // geometric vertical accuracy in version 2, BAQ in version 1, airborne only
+	SIL      uint8 // source integrity level
+	SILSupp  bool  // SIL supplement, true if per sample rather than per hour
+	NICBaro  bool  // barometric altitude cross-checked, airborne only
+	TrackHdg bool  // true if the surface angle is heading rather than track, surface only
+
+	HRD adsbtype.HRD // horizontal reference direction
+
+	LengthWidth uint8 // aircraft length and width code, surface only
+}
+
+// lwTbl is the upper bound of the length and width in meters for each
+// length and width code.
+var lwTbl = [][]float64{
+	{0, 0}, {15, 23}, {25, 28.5}, {25, 34},
+	{35, 33}, {35, 38}, {45, 39.5}, {45, 45},
+	{55, 45}, {55, 52}, {65, 59.5}, {65, 67},
+	{75, 72.5}, {75, 80}, {85, 80}, {85, 90},
+}
+
+// Dimensions returns the upper bound of the aircraft length and width
+// in meters, as reported in a surface operational status message.
+func (s OperationalStatus) Dimensions() (length, width float64, err error) {
+	if s.Subtype != adsbtype.OST1 || s.LengthWidth == 0 || int(s.LengthWidth) >= len(lwTbl) {
+		return 0, 0, newError(ErrNotAvailable, "aircraft dimensions not available")
+	}
+
+	d := lwTbl[s.LengthWidth]
+
+	return d[0], d[1], nil
+}
+
+// OperationalStatus returns the content of an aircraft operational
+// status message.
+func (m *Message) OperationalStatus() (OperationalStatus, error) {
+	tc, err := m.raw.ESType()
+	if err != nil {
+		return OperationalStatus{}, newError(err, "error retrieving operational status")
+	}
+
+	if tc != 31 {
+		return OperationalStatus{}, newError(ErrNotAvailable, "error retrieving operational status")
+	}
+
+	s := OperationalStatus{
+		Subtype:         adsbtype.OST(m.raw.Bits(38, 40)),
+		OperationalMode: m.raw.Bits(57, 72),
+		Version:         adsbtype.VER(m.raw.Bits(73, 75)),
+		NICSuppA:        m.raw.Bit(76) == 1,
+		NACp:            uint8(m.raw.Bits(77, 80)),
+		SIL:             uint8(m.raw.Bits(83, 84)),
+		HRD:             adsbtype.HRD(m.raw.Bit(86)),
+		SILSupp:         m.raw.Bit(87) == 1,
+	}
+
+	switch s.Subtype {
+	case adsbtype.OST0:
+		s.CapabilityClass = m.raw.Bits(41, 56)
+		s.GVA = uint8(m.raw.Bits(81, 82))
+		s.NICBaro = m.raw.Bit(85) == 1
+	case adsbtype.OST1:
+		s.CapabilityClass = m.raw.Bits(41, 52)
+		s.LengthWidth = uint8(m.raw.Bits(53, 56))
+		s.TrackHdg = m.raw.Bit(85) == 1
+
+		if s.Version == adsbtype.VER2 {
+			s.NICSuppC = m.raw.Bit(52) == 1
+		}
+	default:
+		return OperationalStatus{}, newErrorf(ErrNotAvailable, "operational status subtype %d", s.Subtype)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+func TestOperationalStatus(t *testing.T) {
+	for _, tc := range []struct {
+		Name   string
+		Msg    string
+		Status OperationalStatus
+		Length float64
+		Width  float64
+	}{
+		{"AirborneV2", "8da80287f80020000049b8d22e19", OperationalStatus{
+			Subtype:         adsbtype.OST0,
+			Version:         adsbtype.VER2,
+			CapabilityClass: 0x0020,
+			NACp:            9,
+			GVA:             2,
+			SIL:             3,
+			NICBaro:         true,
+			HRD:             adsbtype.HRD0,
+		}, 0, 0},
+		{"SurfaceV2", "8da80287f9001500435a3e39e8c4", OperationalStatus{
+			Subtype:         adsbtype.OST1,
+			Version:         adsbtype.VER2,
+			CapabilityClass: 0x001,
+			OperationalMode: 0x0043,
+			NICSuppA:        true,
+			NICSuppC:        true,
+			NACp:            10,
+			SIL:             3,
+			SILSupp:         true,
+			TrackHdg:        true,
+			HRD:             adsbtype.HRD1,
+			LengthWidth:     5,
+		}, 35, 38},
+		{"AirborneV1", "8da80287f8382000002860c5ad93", OperationalStatus{
+			Subtype:         adsbtype.OST0,
+			Version:         adsbtype.VER1,
+			CapabilityClass: 0x3820,
+			NACp:            8,
+			GVA:             1,
+			SIL:             2,
+		}, 0, 0},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			m := testHexMsg(t, tc.Msg)
+
+			s, err := m.OperationalStatus()
+			if err != nil {
+				t.Fatal("received unexpected error:", err)
+			}
+
+			if s != tc.Status {
+				t.Errorf("received %+v, expected %+v", s, tc.Status)
+			}
+
+			l, w, err := s.Dimensions()
+			if tc.Length == 0 {
+				if !errors.Is(err, ErrNotAvailable) {
+					t.Errorf("expected %s, received %v", ErrNotAvailable, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal("received unexpected error:", err)
+			}
+
+			if l != tc.Length || w != tc.Width {
+				t.Errorf("Dimensions: received %.1f x %.1f, expected %.1f x %.1f",
+					l, w, tc.Length, tc.Width)
+			}
+		})
+	}
+}
+
+func TestOperationalStatusErrors(t *testing.T) {
+	for msg, e := range map[string]string{
+		"8dacf84e23101332cf3ca037ef13": "error retrieving operational status: field not available",
+		"20001910bc45e9":               "error retrieving operational status: error retrieving ESType from 4: field not available",
+		"8da80287fa0020000049b8a6e24e": "operational status subtype 2: field not available",
+	} {
+		m := testHexMsg(t, msg)
+
+		_, err := m.OperationalStatus()
+		if err == nil || err.Error() != e {
+			t.Errorf("expected %s, received %v", e, err)
+		}
+	}
+}
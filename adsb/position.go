@@ -94,6 +94,15 @@ func (c *CPR) DecodeLocal(rp []float64, isAirBorne bool) ([]float64, error) {
 	return coord, nil
 }
 
+// DecodeGlobalPosition decodes a pair of encoded positions with
+// opposite formats to a global latitude and longitude, where c2 is the
+// most recently received position. The return value is in the format
+// [latitude, longitude].
+//
+// Surface positions can only be resolved to one of four quadrants, so
+// referenceLat and referenceLon are required for surface positions and
+// the candidate closest to the reference is returned. The reference is
+// ignored for airborne positions and may be nil.
 func DecodeGlobalPosition(c1 *CPR, c2 *CPR, isAirBorne bool, referenceLat *float64, referenceLon *float64) ([]float64, error) {
 	switch {
 	case c1 == nil || c2 == nil:
@@ -102,6 +111,8 @@ func DecodeGlobalPosition(c1 *CPR, c2 *CPR, isAirBorne bool, referenceLat *float
 		return nil, newError(nil, "bit encoding must be equal")
 	case c1.F == c2.F:
 		return nil, newError(nil, "format must be different")
+	case !isAirBorne && (referenceLat == nil || referenceLon == nil):
+		return nil, newError(nil, "surface position requires a reference")
 	}
 
 	var t0 bool // set t0 to true if the even format is the later message
@@ -122,154 +133,113 @@ func DecodeGlobalPosition(c1 *CPR, c2 *CPR, isAirBorne bool, referenceLat *float
 		lon1 = float64(c1.Lon) / 131072
 	}
 
-	/* for surface vehicles, the following code should be used
-	dlat0 := 90.0 / 60.0 // 360 / 4NZ  = 360 / 15 * 4
-	dlat1 := 90.0 / 59.0 // 360 / 4NZ - 1  = 360 / 15 * 4 - 1
-	*/
-
 	var dlat0, dlat1 float64
 
 	if isAirBorne {
 		dlat0 = 360.0 / 60.0 // 360 / 4NZ  = 360 / 15 * 4
 		dlat1 = 360.0 / 59.0 // 360 / 4NZ - 1  = 360 / 15 * 4 - 1
-
 	} else {
-		dlat0 = 90.0 / 60.0 // 360 / 4NZ  = 360 / 15 * 4
-		dlat1 = 90.0 / 59.0 // 360 / 4NZ - 1  = 360 / 15 * 4 - 1
+		dlat0 = 90.0 / 60.0 // 90 / 4NZ
+		dlat1 = 90.0 / 59.0 // 90 / 4NZ - 1
 	}
 
 	j := math.Floor(((59 * lat0) - (60 * lat1)) + 0.5)
 
 	rlat0 := dlat0 * (mod(j, 60) + lat0)
-	if rlat0 >= 270 {
-		rlat0 -= 360
-	}
-
 	rlat1 := dlat1 * (mod(j, 59) + lat1)
-	if rlat1 >= 270 {
-		rlat1 -= 360
+
+	if isAirBorne {
+		if rlat0 >= 270 {
+			rlat0 -= 360
+		}
+
+		if rlat1 >= 270 {
+			rlat1 -= 360
+		}
+	} else {
+		// surface latitudes are in the range 0 to 90, the southern
+		// hemisphere solution is 90 degrees less
+		rlat0 = surfaceLat(rlat0, *referenceLat)
+		rlat1 = surfaceLat(rlat1, *referenceLat)
 	}
 
 	if cprNL(rlat0) != cprNL(rlat1) {
 		return nil, newError(nil, "positions cross latitude boundary")
 	}
 
-	coord := calcGlobal(t0, lon0, lon1, rlat0, rlat1, isAirBorne, referenceLat, referenceLon)
-
-	//TODO: check if null
+	coord := calcGlobal(t0, lon0, lon1, rlat0, rlat1, isAirBorne, referenceLon)
 
 	return coord, nil
 }
 
-// TODO: works for airborne positions but partially for surface. E.g. Birmingahm (norther hemisphere) is ok but Canbera (southern hemisphere) is not
-// Use local decoding for surface for now and make sure you do sanity check for positions that are too far away from reference positions.
-func calcGlobal(t0 bool, lon0, lon1, rlat0, rlat1 float64, isAirborne bool, referenceLat *float64, referenceLon *float64) []float64 {
-
+// calcGlobal calculates the longitude from a pair of positions, and
+// returns it along with the latitude of the most recent position.
+func calcGlobal(t0 bool, lon0, lon1, rlat0, rlat1 float64, isAirborne bool, referenceLon *float64) []float64 {
 	var nl, ni, dlon, lonc float64
 
 	coord := make([]float64, 2)
 
-	if t0 { //nolint:nestif // variables assigned based on t0 type
+	if t0 {
 		coord[0] = rlat0
 		nl = float64(cprNL(rlat0))
-
-		if nl <= 1 {
-			ni = 1
-		} else {
-			ni = nl
-		}
-
-		if isAirborne {
-			dlon = 360.0 / ni
-		} else {
-			dlon = 90.0 / ni
-		}
-
+		ni = math.Max(nl, 1)
 		lonc = lon0
 	} else {
 		coord[0] = rlat1
 		nl = float64(cprNL(rlat1))
-
-		if nl-1 <= 1 {
-			ni = 1
-		} else {
-			ni = nl - 1
-		}
-
-		if isAirborne {
-			dlon = 360.0 / ni
-		} else {
-			dlon = 90.0 / ni
-		}
+		ni = math.Max(nl-1, 1)
 		lonc = lon1
 	}
 
-	//m := math.Floor(((lon0 * (nl - 1)) - (lon1 * nl)) + 0.5)
+	if isAirborne {
+		dlon = 360.0 / ni
+	} else {
+		dlon = 90.0 / ni
+	}
+
 	m := math.Round(((lon0 * (nl - 1)) - (lon1 * nl)))
 	coord[1] = dlon * (mod(m, ni) + lonc)
+
+	if !isAirborne {
+		// surface longitudes are in the range 0 to 90, select the
+		// quadrant closest to the reference
+		coord[1] = surfaceLon(coord[1], *referenceLon)
+	}
+
 	if coord[1] >= 180 {
 		coord[1] -= 360
 	}
 
-	// Modified candidate selection for longitude (surface messages)
-	if !isAirborne {
-		if referenceLon == nil || referenceLat == nil {
-			return []float64{}
-		}
-		candidates := []float64{}
-		for delta := -360.0; delta <= 360.0; delta += 90.0 {
-			cand := coord[1] + delta
-			if cand > 180 {
-				cand -= 360
-			} else if cand < -180 {
-				cand += 360
-			}
-			candidates = append(candidates, cand)
-		}
-		// Select candidate minimizing the cosine-weighted difference
-		closest := candidates[0]
-		minDiff := math.Abs((*referenceLon - closest) * math.Cos(*referenceLat*math.Pi/180))
-		for _, c := range candidates[1:] {
-			diff := math.Abs((*referenceLon - c) * math.Cos(*referenceLat*math.Pi/180))
-			if diff < minDiff {
-				closest = c
-				minDiff = diff
-			}
-		}
-		coord[1] = closest
+	return coord
+}
+
+// surfaceLat returns the northern or southern hemisphere solution for
+// a surface latitude, whichever is closest to the reference latitude.
+func surfaceLat(lat float64, ref float64) float64 {
+	if math.Abs(lat-90-ref) < math.Abs(lat-ref) {
+		return lat - 90
 	}
 
-	// For surface (non-airborne) messages, adjust latitude by selecting the candidate closest to the reference latitude.
-	if !isAirborne {
-		if referenceLat != nil {
-			candidatesLat := []float64{}
-			// Generate candidate latitudes by adding multiples of 90 degrees.
-			// (We use 90° here because surface CPR covers a 90° zone.)
-			for delta := -180.0; delta <= 180.0; delta += 90.0 {
-				cand := coord[0] + delta
-				// Clamp candidate to valid latitude range.
-				if cand < -90 {
-					cand = -90
-				} else if cand > 90 {
-					cand = 90
-				}
-				candidatesLat = append(candidatesLat, cand)
-			}
-
-			// Select the candidate latitude closest to the reference latitude.
-			closestLat := candidatesLat[0]
-			minDiff := math.Abs(*referenceLat - closestLat)
-			for _, cand := range candidatesLat[1:] {
-				if diff := math.Abs(*referenceLat - cand); diff < minDiff {
-					closestLat = cand
-					minDiff = diff
-				}
-			}
-
-			coord[0] = closestLat
+	return lat
+}
+
+// surfaceLon returns the solution for a surface longitude which is
+// closest to the reference longitude, in the range 0 to 360.
+func surfaceLon(lon float64, ref float64) float64 {
+	best := lon
+	bestDiff := 360.0
+
+	for q := 0.0; q < 4; q++ {
+		cand := mod(lon+(q*90), 360)
+
+		diff := math.Abs(mod(cand-ref+180, 360) - 180)
+		if diff < bestDiff {
+			best = cand
+			bestDiff = diff
 		}
 	}
-	return coord
+
+	return best
 }
 
 // mod implements the MOD function as defined in the ADS-B
@@ -0,0 +1,142 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+)
+
+func TestSurfaceGlobal(t *testing.T) {
+	t.Run("Messages", testSurfaceGlobalMsg)
+	t.Run("Airports", testSurfaceGlobalAirports)
+	t.Run("NoReference", testSurfaceGlobalNoRef)
+}
+
+// test surface global decode of a received pair near Amsterdam.
+func testSurfaceGlobalMsg(t *testing.T) {
+	var cpr [2]*CPR
+
+	for i, m := range []string{
+		"8c4841753aab238733c8cd4020b1",
+		"8c4841753a8a35323faebdac702d",
+	} {
+		b, err := hex.DecodeString(m)
+		if err != nil {
+			t.Fatal("received unexpected error:", err)
+		}
+
+		msg := new(Message)
+
+		err = msg.UnmarshalBinary(b)
+		if err != nil {
+			t.Fatal("received unexpected error:", err)
+		}
+
+		var airborne bool
+
+		cpr[i], airborne, err = msg.CPR()
+		if err != nil {
+			t.Fatal("received unexpected error:", err)
+		}
+
+		if airborne {
+			t.Error("expected surface position")
+		}
+	}
+
+	refLat, refLon := 51.990, 4.375
+
+	c, err := DecodeGlobalPosition(cpr[0], cpr[1], false, &refLat, &refLon)
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	if math.Abs(c[0]-52.32061) > 1e-5 || math.Abs(c[1]-4.73473) > 1e-5 {
+		t.Errorf("received %.5f, %.5f, expected %.5f, %.5f", c[0], c[1], 52.32061, 4.73473)
+	}
+}
+
+// test surface global decode at airports in each hemisphere.
+func testSurfaceGlobalAirports(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Lat0     uint32
+		Lon0     uint32
+		Lat1     uint32
+		Lon1     uint32
+		RefLat   float64
+		RefLon   float64
+		Lat, Lon float64
+	}{
+		{"Canberra", 60561, 74798, 111980, 119661, -35.25, 149.1, -35.30694, 149.19499},
+		{"Sydney", 48400, 40317, 97837, 82294, -33.9, 151.0, -33.94611, 151.17723},
+		{"Auckland", 42986, 36713, 96883, 44298, -37.1, 174.8, -37.00806, 174.79167},
+		{"Johannesburg", 75220, 83076, 113288, 41940, -26.0, 28.0, -26.13917, 28.24611},
+		{"Buenos Aires", 102916, 17104, 22558, 102353, -34.6, -58.4, -34.82221, -58.53583},
+		{"Sao Paulo", 49322, 78617, 83452, 15226, -23.5, -46.6, -23.43556, -46.47306},
+		{"Santiago", 96750, 88413, 14310, 60430, -33.4, -70.7, -33.39278, -70.78584},
+		{"Quito", 119785, 82878, 119973, 65922, -0.2, -78.5, -0.12917, -78.35750},
+		{"Los Angeles", 82357, 69912, 32925, 111285, 34.0, -118.3, 33.94251, -118.40806},
+		{"Anchorage", 102601, 43849, 13509, 153, 61.2, -149.9, 61.17417, -149.99611},
+		{"Honolulu", 27793, 64462, 127818, 32309, 21.3, -157.8, 21.31806, -157.92250},
+		{"Birmingham", 127043, 39423, 50651, 41969, 52.5, -1.9, 52.45389, -1.74806},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			even := &CPR{Nb: 17, F: 0, Lat: tc.Lat0, Lon: tc.Lon0}
+			odd := &CPR{Nb: 17, F: 1, Lat: tc.Lat1, Lon: tc.Lon1}
+
+			for _, pair := range [][]*CPR{{even, odd}, {odd, even}} {
+				c, err := DecodeGlobalPosition(pair[0], pair[1], false, &tc.RefLat, &tc.RefLon)
+				if err != nil {
+					t.Fatal("received unexpected error:", err)
+				}
+
+				if math.Abs(c[0]-tc.Lat) > 1e-4 || math.Abs(c[1]-tc.Lon) > 1e-4 {
+					t.Errorf("received %.5f, %.5f, expected %.5f, %.5f", c[0], c[1], tc.Lat, tc.Lon)
+				}
+			}
+		})
+	}
+}
+
+func testSurfaceGlobalNoRef(t *testing.T) {
+	even := &CPR{Nb: 17, F: 0, Lat: 60561, Lon: 74798}
+	odd := &CPR{Nb: 17, F: 1, Lat: 111980, Lon: 119661}
+
+	c, err := DecodeGlobalPosition(even, odd, false, nil, nil)
+	if err == nil {
+		t.Fatal("expected error, received nil")
+	}
+
+	if err.Error() != "surface position requires a reference" {
+		t.Error("received unexpected error", err)
+	}
+
+	if c != nil {
+		t.Errorf("expected nil, received %v", c)
+	}
+}
@@ -67,6 +67,22 @@ func (r *RawMessage) ESAltitude() (uint64, error) {
 	}
 }
 
+// ESHeight returns the extended squitter GNSS height field.
+func (r *RawMessage) ESHeight() (uint64, error) {
+	tc, err := r.ESType()
+	if err != nil {
+		return 0, err
+	}
+
+	switch tc {
+	case 20, 21, 22:
+		return r.esbits(9, 20), nil
+	default:
+		return 0, newErrorf(ErrNotAvailable, "error retrieving %s from %d",
+			"ESHeight", tc)
+	}
+}
+
 // Get bits from the ME field.
 func (r *RawMessage) esbits(n int, z int) uint64 {
 	return r.Bits(n+32, z+32)
@@ -636,6 +636,7 @@ func TestRawFieldsNotLoaded(t *testing.T) {
 		"ND": rm.ND, "PI": rm.PI, "RI": rm.RI,
 		"SL": rm.SL, "UM": rm.UM, "VS": rm.VS,
 		"ESType": rm.ESType, "ESAltitude": rm.ESAltitude,
+		"ESHeight": rm.ESHeight,
 	}
 
 	expErr := "no data loaded"
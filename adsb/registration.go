@@ -0,0 +1,179 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+// Registration is the content of Comm-B register 2,1, aircraft and
+// airline registration markings. Each value is only meaningful if its
+// corresponding Valid field is true.
+type Registration struct {
+	Aircraft      string // aircraft registration, up to 7 characters
+	AircraftValid bool
+
+	Airline      string // ICAO airline designator, 2 characters
+	AirlineValid bool
+}
+
+// Antenna is the position of one antenna reported in Comm-B register
+// 2,2. The position codes are reported as received.
+type Antenna struct {
+	Type uint8 // antenna type
+	X    uint8 // longitudinal position code
+	Y    uint8 // lateral position code
+	Z    uint8 // vertical position code
+}
+
+// BDS21 decodes the MB field as register 2,1, aircraft and airline
+// registration markings.
+func (c CommB) BDS21() (Registration, error) {
+	var r Registration
+
+	err := c.check(
+		c.status(1, 2, 43),
+		c.status(44, 45, 56),
+	)
+
+	if err == nil && c.bit(1) {
+		r.Aircraft, err = c.chars(2, 7)
+		r.AircraftValid = true
+	}
+
+	if err == nil && c.bit(44) {
+		r.Airline, err = c.chars(45, 2)
+		r.AirlineValid = true
+	}
+
+	if err != nil {
+		return Registration{}, newError(err, "error decoding BDS 2,1")
+	}
+
+	return r, nil
+}
+
+// BDS22 decodes the MB field as register 2,2, antenna positions. Up to
+// three antennas are reported, and unused entries have a type of 0.
+func (c CommB) BDS22() ([]Antenna, error) {
+	err := c.check(c.reserved(55, 56))
+	if err != nil {
+		return nil, newError(err, "error decoding BDS 2,2")
+	}
+
+	var a []Antenna
+
+	for n := 1; n < 55; n += 18 {
+		if c.bits(n, n+2) == 0 {
+			if c.bits(n+3, n+17) != 0 {
+				return nil, newError(
+					newError(ErrInvalidRegister, "position reported without antenna"),
+					"error decoding BDS 2,2")
+			}
+
+			continue
+		}
+
+		a = append(a, Antenna{
+			Type: uint8(c.bits(n, n+2)),
+			X:    uint8(c.bits(n+3, n+8)),
+			Y:    uint8(c.bits(n+9, n+14)),
+			Z:    uint8(c.bits(n+15, n+17)),
+		})
+	}
+
+	return a, nil
+}
+
+// BDS25 decodes the MB field as register 2,5, aircraft type, and
+// returns the ICAO aircraft type designator.
+func (c CommB) BDS25() (string, error) {
+	if c.bits(1, 8) != uint64(adsbtype.BDS25) {
+		return "", newError(
+			newError(ErrInvalidRegister, "BDS mismatch"),
+			"error decoding BDS 2,5")
+	}
+
+	t, err := c.chars(9, 4)
+	if err == nil && t == "" {
+		err = newError(ErrInvalidRegister, "no data")
+	}
+
+	if err != nil {
+		return "", newError(err, "error decoding BDS 2,5")
+	}
+
+	return t, nil
+}
+
+// Registration returns the aircraft registration from a Comm-B reply
+// containing register 2,1.
+func (m *Message) Registration() (string, error) {
+	c, err := m.CommB()
+	if err != nil {
+		return "", newError(err, "error retrieving registration")
+	}
+
+	r, err := c.BDS21()
+	if err == nil && !r.AircraftValid {
+		err = newError(ErrNotAvailable, "aircraft registration not reported")
+	}
+
+	if err != nil {
+		return "", newError(err, "error retrieving registration")
+	}
+
+	return r.Aircraft, nil
+}
+
+// AircraftType returns the ICAO aircraft type designator from a
+// Comm-B reply containing register 2,5.
+func (m *Message) AircraftType() (string, error) {
+	c, err := m.CommB()
+	if err != nil {
+		return "", newError(err, "error retrieving aircraft type")
+	}
+
+	t, err := c.BDS25()
+	if err != nil {
+		return "", newError(err, "error retrieving aircraft type")
+	}
+
+	return t, nil
+}
+
+// Antennas returns the antenna positions from a Comm-B reply
+// containing register 2,2.
+func (m *Message) Antennas() ([]Antenna, error) {
+	c, err := m.CommB()
+	if err != nil {
+		return nil, newError(err, "error retrieving antenna positions")
+	}
+
+	a, err := c.BDS22()
+	if err != nil {
+		return nil, newError(err, "error retrieving antenna positions")
+	}
+
+	return a, nil
+}
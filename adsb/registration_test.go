@@ -0,0 +1,135 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestRegistration(t *testing.T) {
+	m := testHexMsg(t, "a00000008e2aaa8b041081000000")
+
+	reg, err := m.Registration()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	if reg != "GEUUE" {
+		t.Errorf("received %s, expected GEUUE", reg)
+	}
+
+	c := testCommB(t, "a00000008e2aaa8b041081000000")
+
+	r, err := c.BDS21()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	exp := Registration{
+		Aircraft:      "GEUUE",
+		AircraftValid: true,
+		Airline:       "BA",
+		AirlineValid:  true,
+	}
+
+	if r != exp {
+		t.Errorf("received %+v, expected %+v", r, exp)
+	}
+}
+
+func TestAircraftType(t *testing.T) {
+	m := testHexMsg(t, "a000000025073e38000000000000")
+
+	typ, err := m.AircraftType()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	if typ != "A388" {
+		t.Errorf("received %s, expected A388", typ)
+	}
+}
+
+func TestAntennas(t *testing.T) {
+	m := testHexMsg(t, "a00000002a16d500f00000000000")
+
+	a, err := m.Antennas()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	exp := []Antenna{
+		{Type: 1, X: 20, Y: 11, Z: 3},
+		{Type: 2, X: 40, Y: 1, Z: 7},
+	}
+
+	if !reflect.DeepEqual(a, exp) {
+		t.Errorf("received %+v, expected %+v", a, exp)
+	}
+}
+
+func TestRegistrationErrors(t *testing.T) {
+	for _, tc := range []struct {
+		Name   string
+		Msg    string
+		Decode func(*Message) error
+		Error  string
+		Is     error
+	}{
+		{"RegistrationDF", "8dacf84e23101332cf3ca037ef13",
+			func(m *Message) error { _, err := m.Registration(); return err },
+			"error retrieving registration: error retrieving Comm-B data: error retrieving MB from 17: field not available",
+			ErrNotAvailable},
+		{"AircraftTypeMismatch", "a00000008e2aaa8b041081000000",
+			func(m *Message) error { _, err := m.AircraftType(); return err },
+			"error retrieving aircraft type: error decoding BDS 2,5: BDS mismatch: invalid register content",
+			ErrInvalidRegister},
+		{"RegistrationAirline", "a000000000000000001081000000",
+			func(m *Message) error { _, err := m.Registration(); return err },
+			"error retrieving registration: aircraft registration not reported: field not available",
+			ErrNotAvailable},
+		{"AircraftTypeChar", "a000000025000000000000000000",
+			func(m *Message) error { _, err := m.AircraftType(); return err },
+			"error retrieving aircraft type: error decoding BDS 2,5: invalid character: invalid register content",
+			ErrInvalidRegister},
+		{"AntennasPosition", "a000000000000500000000000000",
+			func(m *Message) error { _, err := m.Antennas(); return err },
+			"error retrieving antenna positions: error decoding BDS 2,2: position reported without antenna: invalid register content",
+			ErrInvalidRegister},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Decode(testHexMsg(t, tc.Msg))
+			if err == nil || err.Error() != tc.Error {
+				t.Errorf("expected %s, received %v", tc.Error, err)
+			}
+
+			if !errors.Is(err, tc.Is) {
+				t.Errorf("expected %s, received %v", tc.Is, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"fmt"
+	"time"
+)
+
+// Pairing windows for global position decoding.
+const (
+	AirbornePairWindow    = 10 * time.Second // airborne position reports
+	SurfacePairWindow     = 25 * time.Second // surface reports moving faster than 25 kt
+	SlowSurfacePairWindow = 50 * time.Second // surface reports moving 25 kt or slower
+)
+
+// DefaultLocalReferenceAge is the maximum age of a previously resolved
+// position used for local decoding, if Resolver.LocalReferenceAge is
+// zero.
+const DefaultLocalReferenceAge = 10 * time.Minute
+
+// PositionStatus describes the outcome of resolving a compact position
+// report.
+type PositionStatus uint8
+
+// Position Status values.
+const (
+	PosGlobal      PositionStatus = iota // Decoded from an even and odd pair
+	PosLocal                             // Decoded relative to the last known position
+	PosNoPair                            // No report of the opposite format
+	PosPairExpired                       // Report of the opposite format is too old
+	PosZoneCrossed                       // Reports are in different latitude zones
+	PosNoReference                       // Surface report without a reference position
+	PosRejected                          // Position failed validation
+)
+
+var mPositionStatus = map[PositionStatus]string{
+	PosGlobal:      "Decoded from an even and odd pair",
+	PosLocal:       "Decoded relative to the last known position",
+	PosNoPair:      "No report of the opposite format",
+	PosPairExpired: "Report of the opposite format is too old",
+	PosZoneCrossed: "Reports are in different latitude zones",
+	PosNoReference: "Surface report without a reference position",
+	PosRejected:    "Position failed validation",
+}
+
+// String representation of PositionStatus.
+func (s PositionStatus) String() string {
+	if str, ok := mPositionStatus[s]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", s)
+}
+
+// Position is a position resolved by a Resolver.
+type Position struct {
+	Lat    float64        // latitude in degrees
+	Lon    float64        // longitude in degrees
+	Status PositionStatus // how the position was resolved, or why not
+}
+
+// cprState holds the position reports of a single aircraft.
+type cprState struct {
+	even, odd         *CPR
+	evenTime, oddTime time.Time
+	airborne          bool
+	slow              bool // surface movement of 25 kt or less
+
+	lat, lon float64
+	posTime  time.Time
+}
+
+// Resolver pairs successive compact position reports from each
+// aircraft to produce global positions. It must be created with
+// NewResolver() and is not safe for concurrent use.
+type Resolver struct {
+	// Reference is an optional [latitude, longitude] position of the
+	// receiver. It is required to globally decode surface positions.
+	Reference []float64
+
+	// LocalReferenceAge is the maximum age of a previously resolved
+	// position used to locally decode a report that cannot be paired.
+	// DefaultLocalReferenceAge is used if zero.
+	LocalReferenceAge time.Duration
+
+	// Validator optionally checks each resolved position. A rejected
+	// position also discards the previous position of the aircraft, so
+	// that a single bad position cannot cause every later one to be
+	// rejected, and the report which produced it, so that it cannot be
+	// paired again.
+	Validator *PositionValidator
+
+	state map[uint64]*cprState
+}
+
+// NewResolver returns an empty Resolver.
+func NewResolver() *Resolver {
+	r := new(Resolver)
+	r.state = make(map[uint64]*cprState)
+
+	return r
+}
+
+// ResolveMessage resolves the position report in m, received at ts.
+// Surface reports also update the pairing window from the reported
+// ground speed.
+func (r *Resolver) ResolveMessage(m *Message, ts time.Time) (Position, error) {
+	icao, err := m.ICAO()
+	if err != nil {
+		return Position{}, newError(err, "error resolving position")
+	}
+
+	c, airborne, err := m.CPR()
+	if err != nil {
+		return Position{}, newError(err, "error resolving position")
+	}
+
+	if !airborne {
+		if spd, err := decodeGroundSpeed(int(m.raw.Bits(38, 44))); err == nil {
+			r.aircraft(icao).slow = spd <= 25
+		}
+	}
+
+	return r.Resolve(icao, c, airborne, ts)
+}
+
+// Resolve resolves the position report c from the aircraft with
+// address icao, received at ts.
+//
+// The report is decoded globally if a report of the opposite format was
+// received within the pairing window, and otherwise relative to the
+// last position resolved for the aircraft. If neither is possible, the
+// returned Position contains only the reason in Status, and the
+// returned error wraps ErrNotAvailable. If the position is rejected by
+// the Validator, the returned error wraps ErrImplausiblePosition.
+func (r *Resolver) Resolve(icao uint64, c *CPR, airborne bool, ts time.Time) (Position, error) {
+	if c == nil {
+		return Position{}, newError(nil, "error resolving position: no report")
+	}
+
+	st := r.aircraft(icao)
+
+	if st.airborne != airborne {
+		st.even, st.odd = nil, nil
+		st.airborne = airborne
+	}
+
+	var other *CPR
+
+	var otherTime time.Time
+
+	if c.F == 0 {
+		st.even, st.evenTime = c, ts
+		other, otherTime = st.odd, st.oddTime
+	} else {
+		st.odd, st.oddTime = c, ts
+		other, otherTime = st.even, st.evenTime
+	}
+
+	pos := r.global(st, c, other, ts, otherTime)
+	if pos.Status != PosGlobal && !st.posTime.IsZero() && absDuration(ts.Sub(st.posTime)) <= r.localAge() {
+		coord, err := c.DecodeLocal([]float64{st.lat, st.lon}, airborne)
+		if err == nil {
+			pos = Position{Lat: coord[0], Lon: coord[1], Status: PosLocal}
+		}
+	}
+
+	if pos.Status != PosGlobal && pos.Status != PosLocal {
+		return pos, newErrorf(ErrNotAvailable, "error resolving position: %s", pos.Status)
+	}
+
+	if r.Validator != nil {
+		var prev []float64
+		if !st.posTime.IsZero() {
+			prev = []float64{st.lat, st.lon}
+		}
+
+		err := r.Validator.Check([]float64{pos.Lat, pos.Lon}, prev, ts.Sub(st.posTime))
+		if err != nil {
+			// discard the report so it cannot be paired again
+			if c.F == 0 {
+				st.even = nil
+			} else {
+				st.odd = nil
+			}
+
+			st.posTime = time.Time{}
+
+			return Position{Status: PosRejected}, newError(err, "error resolving position")
+		}
+	}
+
+	st.lat, st.lon, st.posTime = pos.Lat, pos.Lon, ts
+
+	return pos, nil
+}
+
+// Forget discards the reports and last position of an aircraft.
+func (r *Resolver) Forget(icao uint64) {
+	delete(r.state, icao)
+}
+
+// global attempts to decode c paired with other, returning the result
+// or the reason decoding was refused.
+func (r *Resolver) global(st *cprState, c, other *CPR, ts, otherTime time.Time) Position {
+	if other == nil {
+		return Position{Status: PosNoPair}
+	}
+
+	window := AirbornePairWindow
+
+	switch {
+	case !st.airborne && st.slow:
+		window = SlowSurfacePairWindow
+	case !st.airborne:
+		window = SurfacePairWindow
+	}
+
+	if absDuration(ts.Sub(otherTime)) > window {
+		return Position{Status: PosPairExpired}
+	}
+
+	var refLat, refLon *float64
+
+	if len(r.Reference) == 2 {
+		refLat, refLon = &r.Reference[0], &r.Reference[1]
+	} else if !st.airborne {
+		return Position{Status: PosNoReference}
+	}
+
+	// DecodeGlobalPosition expects the most recent report last
+	c1, c2 := other, c
+	if ts.Before(otherTime) {
+		c1, c2 = c, other
+	}
+
+	coord, err := DecodeGlobalPosition(c1, c2, st.airborne, refLat, refLon)
+	if err != nil {
+		return Position{Status: PosZoneCrossed}
+	}
+
+	return Position{Lat: coord[0], Lon: coord[1], Status: PosGlobal}
+}
+
+// aircraft returns the state for icao, creating it if necessary.
+func (r *Resolver) aircraft(icao uint64) *cprState {
+	st, ok := r.state[icao]
+	if !ok {
+		st = &cprState{airborne: true}
+		r.state[icao] = st
+	}
+
+	return st
+}
+
+// localAge returns the maximum age of a local decoding reference.
+func (r *Resolver) localAge() time.Duration {
+	if r.LocalReferenceAge == 0 {
+		return DefaultLocalReferenceAge
+	}
+
+	return r.LocalReferenceAge
+}
+
+// absDuration returns the absolute value of d.
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+
+	return d
+}
@@ -0,0 +1,145 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestResolver(t *testing.T) {
+	t.Run("Airborne", testResolverAirborne)
+	t.Run("Reversed", testResolverReversed)
+	t.Run("SurfaceNoReference", testResolverSurfaceNoRef)
+	t.Run("Status", testResolverStatus)
+}
+
+var resolverT0 = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func testResolverAirborne(t *testing.T) {
+	r := NewResolver()
+
+	testResolve(t, r, "8da8028758ab0028de078689d437", resolverT0,
+		PosNoPair, 0, 0)
+	testResolve(t, r, "8da8028758ab07b0b8876e81eb25", resolverT0.Add(time.Second),
+		PosGlobal, 42.23945229, -89.87851165)
+
+	// pair has expired, decode relative to the previous position
+	testResolve(t, r, "8da8028758ab0028de078689d437", resolverT0.Add(20*time.Second),
+		PosLocal, 42.23945618, -89.87977461)
+
+	r.Forget(0xa80287)
+
+	testResolve(t, r, "8da8028758ab07b0b8876e81eb25", resolverT0.Add(30*time.Second),
+		PosNoPair, 0, 0)
+	testResolve(t, r, "8da8028758ab0028de078689d437", resolverT0.Add(45*time.Second),
+		PosPairExpired, 0, 0)
+}
+
+func testResolverReversed(t *testing.T) {
+	r := NewResolver()
+
+	// reports received out of order
+	testResolve(t, r, "8da8028758ab07b0b8876e81eb25", resolverT0.Add(time.Second),
+		PosNoPair, 0, 0)
+	testResolve(t, r, "8da8028758ab0028de078689d437", resolverT0,
+		PosGlobal, 42.23945229, -89.87851165)
+}
+
+func testResolverSurfaceNoRef(t *testing.T) {
+	r := NewResolver()
+
+	_, err := r.Resolve(0xabcdef, &CPR{Nb: 17, F: 0, Lat: 1000, Lon: 2000}, false, resolverT0)
+	if !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("expected ErrNotAvailable, received %v", err)
+	}
+
+	p, err := r.Resolve(0xabcdef, &CPR{Nb: 17, F: 1, Lat: 1000, Lon: 2000}, false, resolverT0)
+	if !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("expected ErrNotAvailable, received %v", err)
+	}
+
+	if p.Status != PosNoReference {
+		t.Errorf("expected %s, received %s", PosNoReference, p.Status)
+	}
+}
+
+func testResolverStatus(t *testing.T) {
+	if PosGlobal.String() != "Decoded from an even and odd pair" {
+		t.Errorf("received unexpected string %s", PosGlobal)
+	}
+
+	if PositionStatus(99).String() != "Unknown value 99" {
+		t.Errorf("received unexpected string %s", PositionStatus(99))
+	}
+}
+
+func testResolve(t *testing.T, r *Resolver, msg string, ts time.Time,
+	status PositionStatus, lat float64, lon float64) {
+	t.Helper()
+
+	b, err := hex.DecodeString(msg)
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	m := new(Message)
+
+	err = m.UnmarshalBinary(b)
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	p, err := r.ResolveMessage(m, ts)
+
+	if p.Status != status {
+		t.Errorf("expected %s, received %s", status, p.Status)
+	}
+
+	if status == PosRejected {
+		if !errors.Is(err, ErrImplausiblePosition) {
+			t.Errorf("expected ErrImplausiblePosition, received %v", err)
+		}
+
+		return
+	}
+
+	if status != PosGlobal && status != PosLocal {
+		if !errors.Is(err, ErrNotAvailable) {
+			t.Errorf("expected ErrNotAvailable, received %v", err)
+		}
+
+		return
+	}
+
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	if math.Abs(p.Lat-lat) > 1e-6 || math.Abs(p.Lon-lon) > 1e-6 {
+		t.Errorf("received %.8f, %.8f, expected %.8f, %.8f", p.Lat, p.Lon, lat, lon)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+// TargetState is the content of a target state and status message,
+// type code 29. Subtype 1 is transmitted by ADS-B version 2 aircraft
+// and subtype 0 by version 1 aircraft; only the fields for the
+// reported subtype are populated.
+type TargetState struct {
+	Subtype adsbtype.TSS
+
+	NACp    uint8 // navigation accuracy category for position
+	NICBaro bool  // barometric altitude cross-checked
+	SIL     uint8 // source integrity level
+
+	// subtype 1
+	SILSupp bool // SIL supplement, true if per sample rather than per hour
+
+	AltType          adsbtype.SAT
+	SelectedAlt      int64 // selected altitude in feet
+	SelectedAltValid bool
+
+	BaroSetting      float64 // barometric pressure setting in hPa
+	BaroSettingValid bool
+
+	SelectedHeading      float64 // selected heading in degrees [0, 360)
+	SelectedHeadingValid bool
+
+	ModeValid bool // true if the following mode flags are valid
+	Autopilot bool // autopilot engaged
+	VNAV      bool // vertical navigation mode engaged
+	AltHold   bool // altitude hold mode engaged
+	Approach  bool // approach mode engaged
+	LNAV      bool // lateral navigation mode engaged
+	TCAS      bool // ACAS operational
+
+	// subtype 0
+	VerticalSource adsbtype.VDS
+	TargetAltMSL   bool  // true if TargetAlt is referenced to MSL rather than a flight level
+	TargetAltCap   uint8 // target altitude capability
+	VerticalMode   adsbtype.TMI
+	TargetAlt      int64 // target altitude in feet, valid if VerticalSource is not 0
+
+	HorizontalSource adsbtype.HDS
+	TargetAngle      float64 // target heading or track in degrees, valid if HorizontalSource is not 0
+	TargetTrack      bool    // true if TargetAngle is a track angle rather than a heading
+	HorizontalMode   adsbtype.TMI
+
+	CapabilityMode uint8        // capability / mode codes
+	Emergency      adsbtype.EMS // emergency / priority status
+}
+
+// TargetState returns the content of a target state and status
+// message.
+func (m *Message) TargetState() (TargetState, error) {
+	tc, err := m.raw.ESType()
+	if err != nil {
+		return TargetState{}, newError(err, "error retrieving target state")
+	}
+
+	if tc != 29 {
+		return TargetState{}, newError(ErrNotAvailable, "error retrieving target state")
+	}
+
+	s := TargetState{
+		Subtype: adsbtype.TSS(m.raw.Bits(38, 39)),
+		NACp:    uint8(m.raw.Bits(72, 75)),
+		NICBaro: m.raw.Bit(76) == 1,
+		SIL:     uint8(m.raw.Bits(77, 78)),
+	}
+
+	switch s.Subtype {
+	case adsbtype.TSS0:
+		m.targetStateV1(&s)
+	case adsbtype.TSS1:
+		m.targetStateV2(&s)
+	default:
+		return TargetState{}, newErrorf(ErrNotAvailable, "target state subtype %d", s.Subtype)
+	}
+
+	return s, nil
+}
+
+// targetStateV1 decodes the subtype 0 fields.
+func (m *Message) targetStateV1(s *TargetState) {
+	s.VerticalSource = adsbtype.VDS(m.raw.Bits(40, 41))
+	s.TargetAltMSL = m.raw.Bit(42) == 1
+	s.TargetAltCap = uint8(m.raw.Bits(44, 45))
+	s.VerticalMode = adsbtype.TMI(m.raw.Bits(46, 47))
+
+	if alt := m.raw.Bits(48, 57); alt <= 1010 {
+		s.TargetAlt = int64(alt)*100 - 1000
+	} else {
+		s.VerticalSource = adsbtype.VDS0
+	}
+
+	s.HorizontalSource = adsbtype.HDS(m.raw.Bits(58, 59))
+
+	if ang := m.raw.Bits(60, 68); ang < 360 {
+		s.TargetAngle = float64(ang)
+	} else {
+		s.HorizontalSource = adsbtype.HDS0
+	}
+
+	s.TargetTrack = m.raw.Bit(69) == 1
+	s.HorizontalMode = adsbtype.TMI(m.raw.Bits(70, 71))
+	s.CapabilityMode = uint8(m.raw.Bits(84, 85))
+	s.Emergency = adsbtype.EMS(m.raw.Bits(86, 88))
+}
+
+// targetStateV2 decodes the subtype 1 fields.
+func (m *Message) targetStateV2(s *TargetState) {
+	s.SILSupp = m.raw.Bit(40) == 1
+	s.AltType = adsbtype.SAT(m.raw.Bit(41))
+
+	if alt := m.raw.Bits(42, 52); alt != 0 {
+		s.SelectedAlt = int64(alt-1) * 32
+		s.SelectedAltValid = true
+	}
+
+	if baro := m.raw.Bits(53, 61); baro != 0 {
+		s.BaroSetting = 800 + float64(baro-1)*0.8
+		s.BaroSettingValid = true
+	}
+
+	if m.raw.Bit(62) == 1 {
+		s.SelectedHeading = float64(m.raw.Bits(63, 71)) * 180 / 256
+		s.SelectedHeadingValid = true
+	}
+
+	s.ModeValid = m.raw.Bit(79) == 1
+	s.Autopilot = m.raw.Bit(80) == 1
+	s.VNAV = m.raw.Bit(81) == 1
+	s.AltHold = m.raw.Bit(82) == 1
+	s.Approach = m.raw.Bit(84) == 1
+	s.TCAS = m.raw.Bit(85) == 1
+	s.LNAV = m.raw.Bit(86) == 1
+}
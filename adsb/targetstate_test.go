@@ -0,0 +1,101 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"testing"
+
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+func TestTargetState(t *testing.T) {
+	for _, tc := range []struct {
+		Name  string
+		Msg   string
+		State TargetState
+	}{
+		{"Version2", "8da05629ea21485cbf3f8cadaeeb", TargetState{
+			Subtype:              adsbtype.TSS1,
+			NACp:                 9,
+			NICBaro:              true,
+			SIL:                  3,
+			AltType:              adsbtype.SAT0,
+			SelectedAlt:          16992,
+			SelectedAltValid:     true,
+			BaroSetting:          1012.8,
+			BaroSettingValid:     true,
+			SelectedHeading:      66.796875,
+			SelectedHeadingValid: true,
+			ModeValid:            true,
+			Autopilot:            true,
+			VNAV:                 true,
+			LNAV:                 true,
+			TCAS:                 true,
+		}},
+		{"Version1", "8da80287e88cb430ed18089a0caf", TargetState{
+			Subtype:          adsbtype.TSS0,
+			NACp:             8,
+			NICBaro:          true,
+			SIL:              2,
+			VerticalSource:   adsbtype.VDS1,
+			TargetAltCap:     1,
+			VerticalMode:     adsbtype.TMI2,
+			TargetAlt:        35000,
+			HorizontalSource: adsbtype.HDS1,
+			TargetAngle:      270,
+			TargetTrack:      true,
+			HorizontalMode:   adsbtype.TMI2,
+			CapabilityMode:   1,
+		}},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			m := testHexMsg(t, tc.Msg)
+
+			s, err := m.TargetState()
+			if err != nil {
+				t.Fatal("received unexpected error:", err)
+			}
+
+			if s != tc.State {
+				t.Errorf("received %+v, expected %+v", s, tc.State)
+			}
+		})
+	}
+}
+
+func TestTargetStateErrors(t *testing.T) {
+	for msg, e := range map[string]string{
+		"8dacf84e23101332cf3ca037ef13": "error retrieving target state: field not available",
+		"20001910bc45e9":               "error retrieving target state: error retrieving ESType from 4: field not available",
+		"8da80287ec8cb430ed18089a0caf": "target state subtype 2: field not available",
+	} {
+		m := testHexMsg(t, msg)
+
+		_, err := m.TargetState()
+		if err == nil || err.Error() != e {
+			t.Errorf("expected %s, received %v", e, err)
+		}
+	}
+}
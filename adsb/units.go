@@ -0,0 +1,166 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"math"
+
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+// Altitude is an altitude or height in meters.
+type Altitude float64
+
+// AltitudeFromFeet returns the Altitude of ft feet.
+func AltitudeFromFeet(ft float64) Altitude {
+	return Altitude(ft * FEET_TO_METER)
+}
+
+// Meters returns the altitude in meters.
+func (a Altitude) Meters() float64 {
+	return float64(a)
+}
+
+// Feet returns the altitude in feet.
+func (a Altitude) Feet() float64 {
+	return float64(a) / FEET_TO_METER
+}
+
+// Speed is a horizontal speed in m/s.
+type Speed float64
+
+// SpeedFromKnots returns the Speed of kt knots.
+func SpeedFromKnots(kt float64) Speed {
+	return Speed(kt * KNOT_TO_MPS)
+}
+
+// MPS returns the speed in m/s.
+func (s Speed) MPS() float64 {
+	return float64(s)
+}
+
+// Knots returns the speed in knots.
+func (s Speed) Knots() float64 {
+	return float64(s) / KNOT_TO_MPS
+}
+
+// KMH returns the speed in km/h.
+func (s Speed) KMH() float64 {
+	return float64(s) * 3.6
+}
+
+// VerticalRate is a vertical rate in m/s, positive when climbing.
+type VerticalRate float64
+
+// VerticalRateFromFPM returns the VerticalRate of fpm feet per minute.
+func VerticalRateFromFPM(fpm float64) VerticalRate {
+	return VerticalRate(fpm * FEET_PER_MIN_TO_MPS)
+}
+
+// MPS returns the vertical rate in m/s.
+func (v VerticalRate) MPS() float64 {
+	return float64(v)
+}
+
+// FPM returns the vertical rate in feet per minute.
+func (v VerticalRate) FPM() float64 {
+	return float64(v) / FEET_PER_MIN_TO_MPS
+}
+
+// Angle is a direction in degrees clockwise from north, in the range
+// [0, 360).
+type Angle float64
+
+// NewAngle returns the Angle of deg degrees, normalized to the range
+// [0, 360).
+func NewAngle(deg float64) Angle {
+	return Angle(math.Mod(math.Mod(deg, 360)+360, 360))
+}
+
+// Degrees returns the angle in degrees.
+func (a Angle) Degrees() float64 {
+	return float64(a)
+}
+
+// Radians returns the angle in radians.
+func (a Angle) Radians() float64 {
+	return float64(a) * math.Pi / 180
+}
+
+// Altitude returns the barometric altitude, in whichever unit it was
+// reported. BaroAlt provides the original unit.
+func (m *Message) Altitude() (Altitude, error) {
+	meters, feet, unit, err := m.BaroAlt()
+	if err != nil {
+		return 0, err
+	}
+
+	if unit == adsbtype.M1 {
+		return Altitude(meters), nil
+	}
+
+	return AltitudeFromFeet(float64(feet)), nil
+}
+
+// GNSSHeight returns the GNSS height from an airborne position with type
+// code 20 to 22.
+func (m *Message) GNSSHeight() (Altitude, error) {
+	meters, _, err := m.GeometricAlt()
+	if err != nil {
+		return 0, err
+	}
+
+	return Altitude(meters), nil
+}
+
+// GroundVelocity returns the ground speed and track angle from an
+// airborne velocity or surface position message.
+func (m *Message) GroundVelocity() (Speed, Angle, error) {
+	var (
+		spd, trk float64
+		err      error
+	)
+
+	if tc, e := m.raw.ESType(); e == nil && tc >= 5 && tc <= 8 {
+		spd, trk, err = m.SurfaceSpeed()
+	} else {
+		spd, trk, err = m.GroundSpeed()
+	}
+
+	if err != nil {
+		return 0, 0, newError(err, "error retrieving ground velocity")
+	}
+
+	return Speed(spd), NewAngle(trk), nil
+}
+
+// VerticalRate returns the vertical rate from an airborne velocity
+// message.
+func (m *Message) VerticalRate() (VerticalRate, error) {
+	v, err := m.VerticalSpeed()
+	if err != nil {
+		return 0, newError(err, "error retrieving vertical rate")
+	}
+
+	return VerticalRate(v), nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestUnits(t *testing.T) {
+	for _, v := range []struct {
+		Name string
+		Val  float64
+		Exp  float64
+	}{
+		{"AltitudeFeet", Altitude(1000).Feet(), 3280.839895},
+		{"AltitudeMeters", AltitudeFromFeet(1000).Meters(), 304.8},
+		{"SpeedKnots", Speed(100).Knots(), 194.384450},
+		{"SpeedKMH", Speed(100).KMH(), 360},
+		{"SpeedMPS", SpeedFromKnots(100).MPS(), 51.444444},
+		{"VerticalRateFPM", VerticalRate(-5.08).FPM(), -1000},
+		{"VerticalRateMPS", VerticalRateFromFPM(1000).MPS(), 5.08},
+		{"AngleNegative", NewAngle(-90).Degrees(), 270},
+		{"AngleWrap", NewAngle(720).Degrees(), 0},
+		{"AngleRadians", NewAngle(180).Radians(), math.Pi},
+	} {
+		if math.Abs(v.Val-v.Exp) > 1e-6 {
+			t.Errorf("%s: received %f, expected %f", v.Name, v.Val, v.Exp)
+		}
+	}
+}
+
+func TestUnitMethods(t *testing.T) {
+	for _, tc := range []struct {
+		Name string
+		Msg  string
+		Get  func(*Message) (float64, error)
+		Exp  float64
+	}{
+		{"AltitudeFeet", "8da8028758ab0028de078689d437",
+			func(m *Message) (float64, error) { a, err := m.Altitude(); return a.Feet(), err },
+			33000},
+		{"AltitudeMetric", "2000046210fc86",
+			func(m *Message) (float64, error) { a, err := m.Altitude(); return a.Meters(), err },
+			546},
+		{"GNSSHeight", "8da80287a07fa028de0786469665",
+			func(m *Message) (float64, error) { a, err := m.GNSSHeight(); return a.Meters(), err },
+			2042},
+		{"GroundSpeed", "8dc054bd9908dc85986c0c2ebe76",
+			func(m *Message) (float64, error) { s, _, err := m.GroundVelocity(); return s.MPS(), err },
+			114.814503},
+		{"Track", "8dc054bd9908dc85986c0c2ebe76",
+			func(m *Message) (float64, error) { _, a, err := m.GroundVelocity(); return a.Degrees(), err },
+			101.108542},
+		{"SurfaceSpeed", "8c4841753aab238733c8cd4020b1",
+			func(m *Message) (float64, error) { s, _, err := m.GroundVelocity(); return s.Knots(), err },
+			18},
+		{"SurfaceTrack", "8c4841753aab238733c8cd4020b1",
+			func(m *Message) (float64, error) { _, a, err := m.GroundVelocity(); return a.Degrees(), err },
+			140.625},
+		{"VerticalRate", "8dc054bd9908dc85986c0c2ebe76",
+			func(m *Message) (float64, error) { v, err := m.VerticalRate(); return v.FPM(), err },
+			-1664},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			v, err := tc.Get(testHexMsg(t, tc.Msg))
+			if err != nil {
+				t.Fatal("received unexpected error:", err)
+			}
+
+			if math.Abs(v-tc.Exp) > 1e-6 {
+				t.Errorf("received %f, expected %f", v, tc.Exp)
+			}
+		})
+	}
+}
+
+func TestUnitMethodErrors(t *testing.T) {
+	m := testHexMsg(t, "8da80287a07fa028de0786469665")
+
+	if _, err := m.VerticalRate(); !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("VerticalRate: expected %s, received %v", ErrNotAvailable, err)
+	}
+
+	if _, _, err := m.GroundVelocity(); !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("GroundVelocity: expected %s, received %v", ErrNotAvailable, err)
+	}
+
+	if _, err := testHexMsg(t, "8da8028758ab0028de078689d437").GNSSHeight(); !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("GNSSHeight: expected %s, received %v", ErrNotAvailable, err)
+	}
+}
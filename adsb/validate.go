@@ -0,0 +1,93 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"math"
+	"time"
+)
+
+// earthRadius is the mean radius of the earth in meters.
+const earthRadius = 6371008.8
+
+// speedMargin is the distance in meters added to the distance allowed
+// by MaxSpeed, to allow for the resolution of encoded positions.
+const speedMargin = 1852.0
+
+// PositionValidator checks decoded positions for plausibility. A zero
+// value for any limit disables that check.
+type PositionValidator struct {
+	// Receiver is the [latitude, longitude] position of the receiver.
+	Receiver []float64
+
+	// MaxRange is the maximum distance in meters from Receiver.
+	MaxRange float64
+
+	// MaxSpeed is the maximum speed in m/s implied by the distance
+	// from an aircraft's previous position.
+	MaxSpeed float64
+}
+
+// Check returns an error wrapping ErrImplausiblePosition if pos is
+// beyond MaxRange from the receiver, or if moving from prev to pos in
+// the elapsed time dt exceeds MaxSpeed. Positions are in the format
+// [latitude, longitude], and prev may be nil if there is no previous
+// position.
+func (v *PositionValidator) Check(pos []float64, prev []float64, dt time.Duration) error {
+	if len(pos) != 2 {
+		return newError(nil, "must provide [lat, lon] as argument")
+	}
+
+	if v.MaxRange > 0 && len(v.Receiver) == 2 {
+		d := Distance(v.Receiver, pos)
+		if d > v.MaxRange {
+			return newErrorf(ErrImplausiblePosition,
+				"%.0f m from receiver exceeds %.0f m", d, v.MaxRange)
+		}
+	}
+
+	if v.MaxSpeed > 0 && len(prev) == 2 {
+		d := Distance(prev, pos)
+		if d > v.MaxSpeed*math.Abs(dt.Seconds())+speedMargin {
+			return newErrorf(ErrImplausiblePosition,
+				"%.0f m in %s exceeds %.0f m/s", d, dt, v.MaxSpeed)
+		}
+	}
+
+	return nil
+}
+
+// Distance returns the great circle distance in meters between two
+// positions in the format [latitude, longitude]. Both positions must
+// have a length of 2.
+func Distance(p1 []float64, p2 []float64) float64 {
+	lat1 := p1[0] * math.Pi / 180
+	lat2 := p2[0] * math.Pi / 180
+	dlat := lat2 - lat1
+	dlon := (p2[1] - p1[1]) * math.Pi / 180
+
+	a := math.Sin(dlat/2)*math.Sin(dlat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dlon/2)*math.Sin(dlon/2)
+
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
@@ -0,0 +1,122 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestValidator(t *testing.T) {
+	t.Run("Distance", testDistance)
+	t.Run("Range", testValidatorRange)
+	t.Run("Speed", testValidatorSpeed)
+	t.Run("Resolver", testValidatorResolver)
+	t.Run("ResolverCorrupt", testValidatorResolverCorrupt)
+}
+
+func testDistance(t *testing.T) {
+	// one degree of latitude
+	d := Distance([]float64{0, 0}, []float64{1, 0})
+	if math.Abs(d-111195) > 1 {
+		t.Errorf("expected 111195, received %.0f", d)
+	}
+
+	// across the antimeridian
+	d = Distance([]float64{0, 179.5}, []float64{0, -179.5})
+	if math.Abs(d-111195) > 1 {
+		t.Errorf("expected 111195, received %.0f", d)
+	}
+}
+
+func testValidatorRange(t *testing.T) {
+	v := &PositionValidator{
+		Receiver: []float64{43.0, -89.5},
+		MaxRange: 400000,
+	}
+
+	err := v.Check([]float64{42.23945229, -89.87851165}, nil, 0)
+	if err != nil {
+		t.Error("received unexpected error:", err)
+	}
+
+	err = v.Check([]float64{-42.23945229, -89.87851165}, nil, 0)
+	if !errors.Is(err, ErrImplausiblePosition) {
+		t.Errorf("expected ErrImplausiblePosition, received %v", err)
+	}
+}
+
+func testValidatorSpeed(t *testing.T) {
+	v := &PositionValidator{MaxSpeed: 300}
+
+	prev := []float64{42.0, -89.0}
+
+	// 111 km in 10 minutes
+	err := v.Check([]float64{43.0, -89.0}, prev, 10*time.Minute)
+	if err != nil {
+		t.Error("received unexpected error:", err)
+	}
+
+	// 111 km in 1 minute
+	err = v.Check([]float64{43.0, -89.0}, prev, time.Minute)
+	if !errors.Is(err, ErrImplausiblePosition) {
+		t.Errorf("expected ErrImplausiblePosition, received %v", err)
+	}
+}
+
+func testValidatorResolver(t *testing.T) {
+	r := NewResolver()
+	r.Validator = &PositionValidator{
+		Receiver: []float64{-35.3, 149.2},
+		MaxRange: 400000,
+	}
+
+	testResolve(t, r, "8da8028758ab0028de078689d437", resolverT0,
+		PosNoPair, 0, 0)
+	testResolve(t, r, "8da8028758ab07b0b8876e81eb25", resolverT0.Add(time.Second),
+		PosRejected, 0, 0)
+}
+
+func testValidatorResolverCorrupt(t *testing.T) {
+	r := NewResolver()
+	r.Validator = &PositionValidator{
+		MaxSpeed: 300,
+	}
+
+	testResolve(t, r, "8da8028758ab0028de078689d437", resolverT0,
+		PosNoPair, 0, 0)
+	testResolve(t, r, "8da8028758ab07b0b8876e81eb25", resolverT0.Add(time.Second),
+		PosGlobal, 42.23945229, -89.87851165)
+
+	// odd report with a corrupted latitude
+	testResolve(t, r, "8da8028758ab05b0b8876e81eb25", resolverT0.Add(2*time.Second),
+		PosRejected, 0, 0)
+
+	// the corrupted report must not be paired with the next even report
+	testResolve(t, r, "8da8028758ab0028de078689d437", resolverT0.Add(3*time.Second),
+		PosNoPair, 0, 0)
+	testResolve(t, r, "8da8028758ab07b0b8876e81eb25", resolverT0.Add(4*time.Second),
+		PosGlobal, 42.23945229, -89.87851165)
+}
@@ -0,0 +1,205 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"math"
+
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+// Velocity is the horizontal velocity from an airborne velocity
+// message. Subtype identifies the source: ground speed and track for
+// subtypes 1 and 2, or airspeed and magnetic heading for subtypes 3
+// and 4.
+type Velocity struct {
+	Subtype      adsbtype.VST
+	Speed        float64      // ground speed or airspeed, in m/s
+	Angle        float64      // track or magnetic heading, in degrees [0, 360)
+	AngleValid   bool         // false if the heading is not available
+	AirspeedType adsbtype.AST // only valid for subtypes 3 and 4
+}
+
+// IsAirspeed reports whether the velocity is an airspeed and heading.
+func (v Velocity) IsAirspeed() bool {
+	return v.Subtype == adsbtype.VST3 || v.Subtype == adsbtype.VST4
+}
+
+// velocitySubtype returns the subtype of an airborne velocity message.
+func (m *Message) velocitySubtype() (adsbtype.VST, error) {
+	tc, err := m.raw.ESType()
+	if err != nil {
+		return 0, newError(err, "error retrieving velocity")
+	}
+
+	if tc != 19 {
+		return 0, newError(ErrNotAvailable, "velocity not available")
+	}
+
+	st := adsbtype.VST(m.raw.Bits(38, 40))
+	if st < adsbtype.VST1 || st > adsbtype.VST4 {
+		return 0, newErrorf(ErrNotAvailable, "velocity subtype %d", st)
+	}
+
+	return st, nil
+}
+
+// Velocity returns the horizontal velocity, from either ground speed or
+// airspeed depending on the message subtype.
+func (m *Message) Velocity() (Velocity, error) {
+	st, err := m.velocitySubtype()
+	if err != nil {
+		return Velocity{}, err
+	}
+
+	v := Velocity{Subtype: st}
+
+	switch st {
+	case adsbtype.VST1, adsbtype.VST2:
+		v.Speed, v.Angle, err = m.GroundSpeed()
+		if err != nil {
+			return Velocity{}, err
+		}
+
+		v.Angle = math.Mod(v.Angle+360, 360)
+		v.AngleValid = true
+	default:
+		v.Speed, v.AirspeedType, err = m.Airspeed()
+		if err != nil {
+			return Velocity{}, err
+		}
+
+		v.Angle, err = m.Heading()
+		v.AngleValid = err == nil
+	}
+
+	return v, nil
+}
+
+// Airspeed returns the airspeed in m/s and whether it is indicated or
+// true airspeed. It is only available in velocity subtypes 3 and 4.
+func (m *Message) Airspeed() (float64, adsbtype.AST, error) {
+	st, err := m.velocitySubtype()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if st != adsbtype.VST3 && st != adsbtype.VST4 {
+		return 0, 0, newError(ErrNotAvailable, "airspeed not available")
+	}
+
+	as := m.raw.Bits(58, 67)
+	if as == 0 {
+		return 0, 0, newError(ErrNotAvailable, "airspeed not available")
+	}
+
+	v := float64(as - 1)
+	if st == adsbtype.VST4 {
+		v *= 4
+	}
+
+	return v * KNOT_TO_MPS, adsbtype.AST(m.raw.Bit(57)), nil
+}
+
+// Heading returns the magnetic heading in degrees [0, 360). It is only
+// available in velocity subtypes 3 and 4 when the heading status bit is
+// set.
+func (m *Message) Heading() (float64, error) {
+	st, err := m.velocitySubtype()
+	if err != nil {
+		return 0, err
+	}
+
+	if st != adsbtype.VST3 && st != adsbtype.VST4 || m.raw.Bit(46) == 0 {
+		return 0, newError(ErrNotAvailable, "heading not available")
+	}
+
+	return float64(m.raw.Bits(47, 56)) * 360 / 1024, nil
+}
+
+// VerticalRateSource returns whether the vertical rate in an airborne
+// velocity message is derived from GNSS or barometric altitude.
+func (m *Message) VerticalRateSource() (adsbtype.VRSrc, error) {
+	_, err := m.velocitySubtype()
+	if err != nil {
+		return 0, err
+	}
+
+	return adsbtype.VRSrc(m.raw.Bit(68)), nil
+}
+
+// AltitudeDifference returns the GNSS height minus the barometric
+// altitude, in feet.
+func (m *Message) AltitudeDifference() (int64, error) {
+	_, err := m.velocitySubtype()
+	if err != nil {
+		return 0, err
+	}
+
+	d := int64(m.raw.Bits(82, 88))
+	if d == 0 {
+		return 0, newError(ErrNotAvailable, "altitude difference not available")
+	}
+
+	d = (d - 1) * 25
+	if m.raw.Bit(81) == 1 {
+		d = -d
+	}
+
+	return d, nil
+}
+
+// IntentChange returns the intent change flag, which is set for 4
+// seconds after a change of intent such as a new selected altitude.
+func (m *Message) IntentChange() (bool, error) {
+	_, err := m.velocitySubtype()
+	if err != nil {
+		return false, err
+	}
+
+	return m.raw.Bit(41) == 1, nil
+}
+
+// IFRCapability returns the IFR capability flag. In ADS-B version 1 and
+// later, it indicates the ability to support class A1 or above
+// applications.
+func (m *Message) IFRCapability() (bool, error) {
+	_, err := m.velocitySubtype()
+	if err != nil {
+		return false, err
+	}
+
+	return m.raw.Bit(42) == 1, nil
+}
+
+// NACv returns the velocity accuracy field, which is the navigation
+// uncertainty category for velocity (NUCr) in ADS-B version 0 and the
+// navigation accuracy category for velocity (NACv) in later versions.
+func (m *Message) NACv() (uint64, error) {
+	_, err := m.velocitySubtype()
+	if err != nil {
+		return 0, err
+	}
+
+	return m.raw.Bits(43, 45), nil
+}
@@ -0,0 +1,222 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package adsb
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+)
+
+func TestVelocity(t *testing.T) {
+	for _, tc := range []struct {
+		Name         string
+		Msg          string
+		Subtype      adsbtype.VST
+		Speed        float64
+		Angle        float64
+		AngleValid   bool
+		AirspeedType adsbtype.AST
+	}{
+		{"GroundSpeed", "8dc054bd9908dc85986c0c2ebe76",
+			adsbtype.VST1, 114.8145, 101.1085, true, adsbtype.AST0},
+		{"GroundSpeedWest", "8d485020994409940838175b284f",
+			adsbtype.VST1, 81.9001, 182.8803, true, adsbtype.AST0},
+		{"Airspeed", "8da05f219b06b6af189400cbc33f",
+			adsbtype.VST3, 192.9167, 243.9844, true, adsbtype.AST1},
+		{"AirspeedSupersonic", "8da05f219c06b62f189400df3e86",
+			adsbtype.VST4, 771.6667, 243.9844, true, adsbtype.AST0},
+		{"AirspeedNoHeading", "8da05f219b02b6af189400e0b365",
+			adsbtype.VST3, 192.9167, 0, false, adsbtype.AST1},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			m := testHexMsg(t, tc.Msg)
+
+			v, err := m.Velocity()
+			if err != nil {
+				t.Fatal("received unexpected error:", err)
+			}
+
+			if v.Subtype != tc.Subtype {
+				t.Errorf("Subtype: received %s, expected %s", v.Subtype, tc.Subtype)
+			}
+
+			if v.IsAirspeed() != (tc.Subtype >= adsbtype.VST3) {
+				t.Errorf("IsAirspeed: received %t for %s", v.IsAirspeed(), v.Subtype)
+			}
+
+			if math.Abs(v.Speed-tc.Speed) > 0.001 {
+				t.Errorf("Speed: received %f, expected %f", v.Speed, tc.Speed)
+			}
+
+			if math.Abs(v.Angle-tc.Angle) > 0.001 {
+				t.Errorf("Angle: received %f, expected %f", v.Angle, tc.Angle)
+			}
+
+			if v.AngleValid != tc.AngleValid {
+				t.Errorf("AngleValid: received %t, expected %t", v.AngleValid, tc.AngleValid)
+			}
+
+			if v.AirspeedType != tc.AirspeedType {
+				t.Errorf("AirspeedType: received %s, expected %s", v.AirspeedType, tc.AirspeedType)
+			}
+		})
+	}
+}
+
+func TestVelocityErrors(t *testing.T) {
+	t.Run("NotVelocity", testVelocityNotVelocity)
+	t.Run("NoAirspeed", testVelocityNoAirspeed)
+	t.Run("GroundSpeedSubtype", testVelocityGroundSpeedSubtype)
+}
+
+func testVelocityNotVelocity(t *testing.T) {
+	m := testHexMsg(t, "8dacf84e23101332cf3ca037ef13")
+
+	_, err := m.Velocity()
+	if !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("expected %s, received %v", ErrNotAvailable, err)
+	}
+
+	_, err = m.Heading()
+	if !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("expected %s, received %v", ErrNotAvailable, err)
+	}
+
+	_, err = m.VerticalRateSource()
+	if !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("expected %s, received %v", ErrNotAvailable, err)
+	}
+
+	_, err = m.AltitudeDifference()
+	if !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("expected %s, received %v", ErrNotAvailable, err)
+	}
+}
+
+func testVelocityNoAirspeed(t *testing.T) {
+	m := testHexMsg(t, "8da05f219b06b680189400384948")
+
+	_, err := m.Velocity()
+	if err == nil || err.Error() != "airspeed not available: field not available" {
+		t.Errorf("received unexpected error %v", err)
+	}
+
+	h, err := m.Heading()
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	if math.Abs(h-243.9844) > 0.001 {
+		t.Errorf("Heading: received %f, expected 243.9844", h)
+	}
+}
+
+func testVelocityGroundSpeedSubtype(t *testing.T) {
+	m := testHexMsg(t, "8dc054bd9908dc85986c0c2ebe76")
+
+	_, _, err := m.Airspeed()
+	if err == nil || err.Error() != "airspeed not available: field not available" {
+		t.Errorf("received unexpected error %v", err)
+	}
+
+	_, err = m.Heading()
+	if err == nil || err.Error() != "heading not available: field not available" {
+		t.Errorf("received unexpected error %v", err)
+	}
+}
+
+func TestVelocityStatus(t *testing.T) {
+	for _, tc := range []struct {
+		Name         string
+		Msg          string
+		Source       adsbtype.VRSrc
+		Diff         int64
+		DiffError    string
+		IntentChange bool
+		IFR          bool
+		NACv         uint64
+	}{
+		{"Barometric", "8dc054bd9908dc85986c0c2ebe76", adsbtype.VRSrc1, 275, "", false, false, 1},
+		{"GNSS", "8d485020994409940838175b284f", adsbtype.VRSrc0, 550, "", false, true, 0},
+		{"NoDifference", "8da05f219b06b6af189400cbc33f", adsbtype.VRSrc1, 0,
+			"altitude difference not available: field not available", false, false, 0},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			m := testHexMsg(t, tc.Msg)
+
+			src, err := m.VerticalRateSource()
+			if err != nil {
+				t.Fatal("received unexpected error:", err)
+			}
+
+			if src != tc.Source {
+				t.Errorf("VerticalRateSource: received %s, expected %s", src, tc.Source)
+			}
+
+			d, err := m.AltitudeDifference()
+			if tc.DiffError != "" {
+				if err == nil || err.Error() != tc.DiffError {
+					t.Errorf("expected %s, received %v", tc.DiffError, err)
+				}
+			} else if err != nil {
+				t.Error("received unexpected error:", err)
+			} else if d != tc.Diff {
+				t.Errorf("AltitudeDifference: received %d, expected %d", d, tc.Diff)
+			}
+
+			ic, err := m.IntentChange()
+			if err != nil {
+				t.Fatal("received unexpected error:", err)
+			}
+
+			if ic != tc.IntentChange {
+				t.Errorf("IntentChange: received %t, expected %t", ic, tc.IntentChange)
+			}
+
+			ifr, err := m.IFRCapability()
+			if err != nil {
+				t.Fatal("received unexpected error:", err)
+			}
+
+			if ifr != tc.IFR {
+				t.Errorf("IFRCapability: received %t, expected %t", ifr, tc.IFR)
+			}
+
+			nac, err := m.NACv()
+			if err != nil {
+				t.Fatal("received unexpected error:", err)
+			}
+
+			if nac != tc.NACv {
+				t.Errorf("NACv: received %d, expected %d", nac, tc.NACv)
+			}
+		})
+	}
+}
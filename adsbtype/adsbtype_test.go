@@ -38,16 +38,32 @@ func TestConst(t *testing.T) {
 		adsbtype.DF0: "adsbtype.DF: Short air-air surveillance (ACAS)",
 		adsbtype.DR0: "adsbtype.DR: No request",
 		adsbtype.FS0: "adsbtype.FS: No alert, no SPI, airborne",
+		adsbtype.M0:  "adsbtype.M: Feet",
 		adsbtype.RI0: "adsbtype.RI: No ACAS",
 		adsbtype.SL0: "adsbtype.SL: ACAS inoperative",
 		adsbtype.VS0: "adsbtype.VS: Airborne",
 
 		adsbtype.ATS0:  "adsbtype.ATS: Barometric altitude",
 		adsbtype.BDS02: "adsbtype.BDS: Linked Comm-B, segment 2",
+		adsbtype.FOM0:  "adsbtype.FOM: Invalid",
+		adsbtype.HZL0:  "adsbtype.HZL: Nil",
 		adsbtype.SSS0:  "adsbtype.SSS: No condition information",
 		adsbtype.TRS0:  "adsbtype.TRS: No capability",
+		adsbtype.TTI0:  "adsbtype.TTI: No identity data",
 
-		adsbtype.TYPE0: "adsbtype.TYPE: No position information",
+		adsbtype.TYPE0:  "adsbtype.TYPE: No position information",
+		adsbtype.VST1:   "adsbtype.VST: Ground speed, subsonic",
+		adsbtype.AST0:   "adsbtype.AST: Indicated airspeed",
+		adsbtype.VRSrc0: "adsbtype.VRSrc: GNSS",
+		adsbtype.OST0:   "adsbtype.OST: Airborne status",
+		adsbtype.VER0:   "adsbtype.VER: DO-260",
+		adsbtype.HRD0:   "adsbtype.HRD: True north",
+		adsbtype.EMS0:   "adsbtype.EMS: No emergency",
+		adsbtype.TSS0:   "adsbtype.TSS: Version 1 target state and status",
+		adsbtype.SAT0:   "adsbtype.SAT: MCP / FCU",
+		adsbtype.VDS0:   "adsbtype.VDS: No valid vertical target state data",
+		adsbtype.HDS0:   "adsbtype.HDS: No valid horizontal target state data",
+		adsbtype.TMI0:   "adsbtype.TMI: Unknown mode or information unavailable",
 	} {
 		result := fmt.Sprintf("%T: %s", val, val)
 		if result != out {
@@ -64,16 +80,32 @@ func TestConstUnknown(t *testing.T) {
 		adsbtype.DF(99): "adsbtype.DF: Unknown value 99",
 		adsbtype.DR(99): "adsbtype.DR: Unknown value 99",
 		adsbtype.FS(99): "adsbtype.FS: Unknown value 99",
+		adsbtype.M(99):  "adsbtype.M: Unknown value 99",
 		adsbtype.RI(99): "adsbtype.RI: Unknown value 99",
 		adsbtype.SL(99): "adsbtype.SL: Unknown value 99",
 		adsbtype.VS(99): "adsbtype.VS: Unknown value 99",
 
 		adsbtype.ATS(99):   "adsbtype.ATS: Unknown value 99",
 		adsbtype.BDS(0x99): "adsbtype.BDS: Unknown value 99",
+		adsbtype.FOM(99):   "adsbtype.FOM: Unknown value 99",
+		adsbtype.HZL(99):   "adsbtype.HZL: Unknown value 99",
 		adsbtype.SSS(99):   "adsbtype.SSS: Unknown value 99",
 		adsbtype.TRS(99):   "adsbtype.TRS: Unknown value 99",
+		adsbtype.TTI(99):   "adsbtype.TTI: Unknown value 99",
 
-		adsbtype.TYPE(99): "adsbtype.TYPE: Unknown value 99",
+		adsbtype.TYPE(99):  "adsbtype.TYPE: Unknown value 99",
+		adsbtype.VST(99):   "adsbtype.VST: Unknown value 99",
+		adsbtype.AST(99):   "adsbtype.AST: Unknown value 99",
+		adsbtype.VRSrc(99): "adsbtype.VRSrc: Unknown value 99",
+		adsbtype.OST(99):   "adsbtype.OST: Unknown value 99",
+		adsbtype.VER(99):   "adsbtype.VER: Unknown value 99",
+		adsbtype.HRD(99):   "adsbtype.HRD: Unknown value 99",
+		adsbtype.EMS(99):   "adsbtype.EMS: Unknown value 99",
+		adsbtype.TSS(99):   "adsbtype.TSS: Unknown value 99",
+		adsbtype.SAT(99):   "adsbtype.SAT: Unknown value 99",
+		adsbtype.VDS(99):   "adsbtype.VDS: Unknown value 99",
+		adsbtype.HDS(99):   "adsbtype.HDS: Unknown value 99",
+		adsbtype.TMI(99):   "adsbtype.TMI: Unknown value 99",
 	} {
 		result := fmt.Sprintf("%T: %s", val, val)
 		if result != out {
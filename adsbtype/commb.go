@@ -169,6 +169,62 @@ func (c BDS) String() string {
 	return fmt.Sprintf("Unknown value %02x", uint64(c))
 }
 
+// FOM is the figure of merit of meteorological wind data.
+type FOM uint64
+
+// Figure Of Merit values.
+const (
+	FOM0 FOM = 0 // Invalid
+	FOM1 FOM = 1 // INS
+	FOM2 FOM = 2 // GNSS
+	FOM3 FOM = 3 // DME/DME
+	FOM4 FOM = 4 // VOR/DME
+)
+
+var mFOM = map[FOM]string{
+	FOM0: "Invalid",
+	FOM1: "INS",
+	FOM2: "GNSS",
+	FOM3: "DME/DME",
+	FOM4: "VOR/DME",
+}
+
+// String representation of FOM.
+func (c FOM) String() string {
+	if str, ok := mFOM[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
+// HZL is the severity level of a meteorological hazard.
+type HZL uint64
+
+// Hazard Level values.
+const (
+	HZL0 HZL = 0 // Nil
+	HZL1 HZL = 1 // Light
+	HZL2 HZL = 2 // Moderate
+	HZL3 HZL = 3 // Severe
+)
+
+var mHZL = map[HZL]string{
+	HZL0: "Nil",
+	HZL1: "Light",
+	HZL2: "Moderate",
+	HZL3: "Severe",
+}
+
+// String representation of HZL.
+func (c HZL) String() string {
+	if str, ok := mHZL[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
 // SSS is the surveillance status subfield.
 type SSS uint64
 
@@ -220,3 +276,30 @@ func (c TRS) String() string {
 
 	return fmt.Sprintf("Unknown value %d", c)
 }
+
+// TTI is the threat type indicator.
+type TTI uint64
+
+// Threat Type Indicator values.
+const (
+	TTI0 TTI = 0 // No identity data
+	TTI1 TTI = 1 // Mode S address
+	TTI2 TTI = 2 // Altitude, range and bearing
+	TTI3 TTI = 3 // Not assigned
+)
+
+var mTTI = map[TTI]string{
+	TTI0: "No identity data",
+	TTI1: "Mode S address",
+	TTI2: "Altitude, range and bearing",
+	TTI3: "Not assigned",
+}
+
+// String representation of TTI.
+func (c TTI) String() string {
+	if str, ok := mTTI[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
@@ -55,6 +55,7 @@ const (
 	TYPE21 TYPE = 21 // Airborne position, 25 meter, GNSS height
 	TYPE22 TYPE = 22 // Airborne position, GNSS height
 	TYPE28 TYPE = 28 // Emergency priority status
+	TYPE29 TYPE = 29 // Target state and status
 	TYPE31 TYPE = 31 // Operational status
 )
 
@@ -83,6 +84,7 @@ var mTYPE = map[TYPE]string{
 	TYPE21: "Airborne position, 25 meter, GNSS height",
 	TYPE22: "Airborne position, GNSS height",
 	TYPE28: "Emergency priority status",
+	TYPE29: "Target state and status",
 	TYPE31: "Operational status",
 }
 
@@ -163,3 +165,309 @@ func (c AcCat) String() string {
 
 	return fmt.Sprintf("Unknown value %s", string(c))
 }
+
+// VST is the airborne velocity subtype.
+type VST uint64
+
+// Airborne velocity subtype values.
+const (
+	VST1 VST = 1 // Ground speed, subsonic
+	VST2 VST = 2 // Ground speed, supersonic
+	VST3 VST = 3 // Airspeed and heading, subsonic
+	VST4 VST = 4 // Airspeed and heading, supersonic
+)
+
+var mVST = map[VST]string{
+	VST1: "Ground speed, subsonic",
+	VST2: "Ground speed, supersonic",
+	VST3: "Airspeed and heading, subsonic",
+	VST4: "Airspeed and heading, supersonic",
+}
+
+// String representation of VST.
+func (c VST) String() string {
+	if str, ok := mVST[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
+// AST is the airspeed type.
+type AST uint64
+
+// Airspeed type values.
+const (
+	AST0 AST = 0 // Indicated airspeed
+	AST1 AST = 1 // True airspeed
+)
+
+var mAST = map[AST]string{
+	AST0: "Indicated airspeed",
+	AST1: "True airspeed",
+}
+
+// String representation of AST.
+func (c AST) String() string {
+	if str, ok := mAST[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
+// VRSrc is the vertical rate source.
+type VRSrc uint64
+
+// Vertical rate source values.
+const (
+	VRSrc0 VRSrc = 0 // GNSS
+	VRSrc1 VRSrc = 1 // Barometric
+)
+
+var mVRSrc = map[VRSrc]string{
+	VRSrc0: "GNSS",
+	VRSrc1: "Barometric",
+}
+
+// String representation of VRSrc.
+func (c VRSrc) String() string {
+	if str, ok := mVRSrc[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
+// OST is the operational status subtype.
+type OST uint64
+
+// Operational status subtype values.
+const (
+	OST0 OST = 0 // Airborne status
+	OST1 OST = 1 // Surface status
+)
+
+var mOST = map[OST]string{
+	OST0: "Airborne status",
+	OST1: "Surface status",
+}
+
+// String representation of OST.
+func (c OST) String() string {
+	if str, ok := mOST[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
+// VER is the ADS-B version number.
+type VER uint64
+
+// ADS-B version number values.
+const (
+	VER0 VER = 0 // DO-260
+	VER1 VER = 1 // DO-260A
+	VER2 VER = 2 // DO-260B
+)
+
+var mVER = map[VER]string{
+	VER0: "DO-260",
+	VER1: "DO-260A",
+	VER2: "DO-260B",
+}
+
+// String representation of VER.
+func (c VER) String() string {
+	if str, ok := mVER[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
+// HRD is the horizontal reference direction.
+type HRD uint64
+
+// Horizontal reference direction values.
+const (
+	HRD0 HRD = 0 // True north
+	HRD1 HRD = 1 // Magnetic north
+)
+
+var mHRD = map[HRD]string{
+	HRD0: "True north",
+	HRD1: "Magnetic north",
+}
+
+// String representation of HRD.
+func (c HRD) String() string {
+	if str, ok := mHRD[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
+// EMS is the emergency state.
+type EMS uint64
+
+// Emergency state values.
+const (
+	EMS0 EMS = 0 // No emergency
+	EMS1 EMS = 1 // General emergency
+	EMS2 EMS = 2 // Lifeguard / medical emergency
+	EMS3 EMS = 3 // Minimum fuel
+	EMS4 EMS = 4 // No communications
+	EMS5 EMS = 5 // Unlawful interference
+	EMS6 EMS = 6 // Downed aircraft
+	EMS7 EMS = 7 // Reserved
+)
+
+var mEMS = map[EMS]string{
+	EMS0: "No emergency",
+	EMS1: "General emergency",
+	EMS2: "Lifeguard / medical emergency",
+	EMS3: "Minimum fuel",
+	EMS4: "No communications",
+	EMS5: "Unlawful interference",
+	EMS6: "Downed aircraft",
+	EMS7: "Reserved",
+}
+
+// String representation of EMS.
+func (c EMS) String() string {
+	if str, ok := mEMS[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
+// TSS is the target state and status subtype.
+type TSS uint64
+
+// Target state and status subtype values.
+const (
+	TSS0 TSS = 0 // Version 1 target state and status
+	TSS1 TSS = 1 // Version 2 target state and status
+)
+
+var mTSS = map[TSS]string{
+	TSS0: "Version 1 target state and status",
+	TSS1: "Version 2 target state and status",
+}
+
+// String representation of TSS.
+func (c TSS) String() string {
+	if str, ok := mTSS[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
+// SAT is the selected altitude type.
+type SAT uint64
+
+// Selected altitude type values.
+const (
+	SAT0 SAT = 0 // MCP / FCU
+	SAT1 SAT = 1 // FMS
+)
+
+var mSAT = map[SAT]string{
+	SAT0: "MCP / FCU",
+	SAT1: "FMS",
+}
+
+// String representation of SAT.
+func (c SAT) String() string {
+	if str, ok := mSAT[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
+// VDS is the version 1 vertical data available / source indicator.
+type VDS uint64
+
+// Vertical data source values.
+const (
+	VDS0 VDS = 0 // No valid vertical target state data
+	VDS1 VDS = 1 // Autopilot control panel selected value
+	VDS2 VDS = 2 // Holding altitude
+	VDS3 VDS = 3 // FMS / RNAV system
+)
+
+var mVDS = map[VDS]string{
+	VDS0: "No valid vertical target state data",
+	VDS1: "Autopilot control panel selected value",
+	VDS2: "Holding altitude",
+	VDS3: "FMS / RNAV system",
+}
+
+// String representation of VDS.
+func (c VDS) String() string {
+	if str, ok := mVDS[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
+// HDS is the version 1 horizontal data available / source indicator.
+type HDS uint64
+
+// Horizontal data source values.
+const (
+	HDS0 HDS = 0 // No valid horizontal target state data
+	HDS1 HDS = 1 // Autopilot control panel selected value
+	HDS2 HDS = 2 // Maintaining current heading or track angle
+	HDS3 HDS = 3 // FMS / RNAV system
+)
+
+var mHDS = map[HDS]string{
+	HDS0: "No valid horizontal target state data",
+	HDS1: "Autopilot control panel selected value",
+	HDS2: "Maintaining current heading or track angle",
+	HDS3: "FMS / RNAV system",
+}
+
+// String representation of HDS.
+func (c HDS) String() string {
+	if str, ok := mHDS[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
+// TMI is the version 1 vertical or horizontal mode indicator.
+type TMI uint64
+
+// Mode indicator values.
+const (
+	TMI0 TMI = 0 // Unknown mode or information unavailable
+	TMI1 TMI = 1 // Acquiring mode
+	TMI2 TMI = 2 // Capturing or maintaining mode
+	TMI3 TMI = 3 // Reserved
+)
+
+var mTMI = map[TMI]string{
+	TMI0: "Unknown mode or information unavailable",
+	TMI1: "Acquiring mode",
+	TMI2: "Capturing or maintaining mode",
+	TMI3: "Reserved",
+}
+
+// String representation of TMI.
+func (c TMI) String() string {
+	if str, ok := mTMI[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
@@ -339,6 +339,29 @@ func (c FS) String() string {
 	return fmt.Sprintf("Unknown value %d", c)
 }
 
+// M is the altitude unit bit of the altitude code.
+type M uint64
+
+// Altitude Unit values.
+const (
+	M0 M = 0 // Feet
+	M1 M = 1 // Meters
+)
+
+var mM = map[M]string{
+	M0: "Feet",
+	M1: "Meters",
+}
+
+// String representation of M.
+func (c M) String() string {
+	if str, ok := mM[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
+
 // RI is the reply information.
 type RI uint64
 
@@ -0,0 +1,106 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package avr
+
+import (
+	"bufio"
+	"bytes"
+	"encoding"
+	"io"
+)
+
+// Decoder reads an AVR stream and stores individual frames. It must be
+// created with NewDecoder().
+type Decoder struct {
+	r *bufio.Reader
+}
+
+// NewDecoder returns a Decoder which reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	d := new(Decoder)
+	d.r = bufio.NewReader(r)
+
+	return d
+}
+
+// Decode reads the next AVR frame from the input source and stores it
+// in f. Line endings and any data preceding the start of the frame are
+// discarded. The data passed to f remains valid only until the next
+// call to Decode().
+func (d *Decoder) Decode(f encoding.TextUnmarshaler) error {
+	b, err := d.r.ReadSlice(';')
+	if err != nil {
+		return readError(err)
+	}
+
+	n := bytes.LastIndexAny(b, string([]byte{
+		TypeRaw, TypeMLAT, TypeMLATNoCRC, TypeMLATSignal,
+	}))
+	if n < 0 {
+		return newError(nil, "no frame data found")
+	}
+
+	err = f.UnmarshalText(b[n:])
+	if err != nil {
+		return newError(err, "error unmarshalling data")
+	}
+
+	return nil
+}
+
+// Encoder writes an AVR stream. It must be created with NewEncoder().
+type Encoder struct {
+	w io.Writer
+}
+
+// NewEncoder returns an Encoder which writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	e := new(Encoder)
+	e.w = w
+
+	return e
+}
+
+// Encode writes the AVR representation of f, followed by a newline, to
+// the output stream.
+func (e *Encoder) Encode(f encoding.TextMarshaler) error {
+	b, err := f.MarshalText()
+	if err != nil {
+		return newError(err, "error marshalling data")
+	}
+
+	_, err = e.w.Write(append(b, '\n'))
+	if err != nil {
+		return newError(err, "error writing stream")
+	}
+
+	return nil
+}
+
+// readError returns a read error.
+func readError(w error) avrError {
+	return avrError{
+		msg:  "error reading stream",
+		werr: w,
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package avr_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/NeuronInnovations/go-adsb/adsb"
+	"github.com/NeuronInnovations/go-adsb/avr"
+)
+
+const testStream = "*8D4840D6202CC371C32CE0576098;\r\n" +
+	"garbage@0000000000C08D4840D6202CC371C32CE0576098;\n" +
+	"<0000000000C0C48D4840D6202CC371C32CE0576098;\n" +
+	"*5047;\n"
+
+func TestDecode(t *testing.T) {
+	d := avr.NewDecoder(strings.NewReader(testStream))
+	f := new(avr.Frame)
+
+	for _, typ := range []byte{'*', '@', '<'} {
+		err := d.Decode(f)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		ft, _ := f.Type()
+		if ft != typ {
+			t.Errorf("expected %c, received %c", typ, ft)
+		}
+
+		data, err := f.ModeS()
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		m := new(adsb.Message)
+
+		err = m.UnmarshalBinary(data)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		call, err := m.Call()
+		if err != nil || call != "KLM1023" {
+			t.Errorf("expected KLM1023, received %s %v", call, err)
+		}
+	}
+
+	err := d.Decode(f)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if _, err := f.ModeAC(); err != nil {
+		t.Error("unexpected error:", err)
+	}
+
+	err = d.Decode(f)
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("expected EOF, received %v", err)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	d := avr.NewDecoder(strings.NewReader("8D4840D6202CC371C32CE0576098;*ZZ;"))
+	f := new(avr.Frame)
+
+	err := d.Decode(f)
+	if err == nil || err.Error() != "no frame data found" {
+		t.Errorf("expected no frame data found, received %v", err)
+	}
+
+	err = d.Decode(f)
+	if err == nil || !strings.HasPrefix(err.Error(), "error unmarshalling data") {
+		t.Errorf("expected error unmarshalling data, received %v", err)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	d := avr.NewDecoder(strings.NewReader(testStream))
+	f := new(avr.Frame)
+
+	var out bytes.Buffer
+
+	e := avr.NewEncoder(&out)
+
+	for {
+		err := d.Decode(f)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		err = e.Encode(f)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	exp := "*8D4840D6202CC371C32CE0576098;\n" +
+		"@0000000000C08D4840D6202CC371C32CE0576098;\n" +
+		"<0000000000C0C48D4840D6202CC371C32CE0576098;\n" +
+		"*5047;\n"
+
+	if out.String() != exp {
+		t.Errorf("expected %q, received %q", exp, out.String())
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package avr
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Frame types.
+const (
+	TypeRaw        byte = '*' // message only
+	TypeMLAT       byte = '@' // MLAT timestamp and message
+	TypeMLATNoCRC  byte = '%' // MLAT timestamp and message, CRC not checked
+	TypeMLATSignal byte = '<' // MLAT timestamp, signal level and message
+)
+
+// Frame is an AVR format message. A Frame is safe to reuse by calling
+// UnmarshalText with new data.
+type Frame struct {
+	typ  byte
+	ts   uint64
+	sig  uint8
+	data bytes.Buffer
+}
+
+// NewFrame returns a Frame of type typ containing a 2 byte Mode A/C or
+// 7 or 14 byte Mode S message. The 48 bit MLAT timestamp ts, in 12 MHz
+// ticks, and the signal level sig are ignored if the type does not
+// carry them.
+func NewFrame(typ byte, ts uint64, sig uint8, data []byte) (*Frame, error) {
+	switch typ {
+	case TypeRaw, TypeMLAT, TypeMLATNoCRC, TypeMLATSignal:
+	default:
+		return nil, newErrorf(nil, "invalid frame type: %q", typ)
+	}
+
+	if ts >= 1<<48 {
+		return nil, newErrorf(nil, "timestamp exceeds 48 bits: %d", ts)
+	}
+
+	f := new(Frame)
+
+	err := f.set(typ, ts, sig, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalText stores an AVR message, such as
+// "*8D4840D6202CC371C32CE0576098;". Surrounding white space is ignored.
+func (f *Frame) UnmarshalText(text []byte) error {
+	f.typ = 0
+	f.data.Reset()
+
+	text = bytes.TrimSpace(text)
+
+	if len(text) < 2 || text[len(text)-1] != ';' {
+		return newErrorf(nil, "invalid data format: %q", text)
+	}
+
+	typ := text[0]
+	body := text[1 : len(text)-1]
+
+	var tsLen, sigLen int
+
+	switch typ {
+	case TypeRaw:
+	case TypeMLAT, TypeMLATNoCRC:
+		tsLen = 12
+	case TypeMLATSignal:
+		tsLen = 12
+		sigLen = 2
+	default:
+		return newErrorf(nil, "invalid frame type: %q", typ)
+	}
+
+	if len(body) < tsLen+sigLen {
+		return newError(nil, "received truncated data")
+	}
+
+	var ts, sig uint64
+
+	var err error
+
+	if tsLen > 0 {
+		ts, err = strconv.ParseUint(string(body[:tsLen]), 16, 48)
+		if err != nil {
+			return newError(err, "invalid timestamp")
+		}
+	}
+
+	if sigLen > 0 {
+		sig, err = strconv.ParseUint(string(body[tsLen:tsLen+sigLen]), 16, 8)
+		if err != nil {
+			return newError(err, "invalid signal level")
+		}
+	}
+
+	data := make([]byte, hex.DecodedLen(len(body)-tsLen-sigLen))
+
+	_, err = hex.Decode(data, body[tsLen+sigLen:])
+	if err != nil {
+		return newError(err, "invalid message data")
+	}
+
+	return f.set(typ, ts, uint8(sig), data)
+}
+
+// MarshalText returns an AVR message.
+func (f *Frame) MarshalText() ([]byte, error) {
+	if f.typ == 0 {
+		return nil, ErrNoData
+	}
+
+	ob := bytes.NewBuffer(make([]byte, 0, 45))
+	ob.WriteByte(f.typ)
+
+	switch f.typ {
+	case TypeMLAT, TypeMLATNoCRC:
+		fmt.Fprintf(ob, "%012X", f.ts)
+	case TypeMLATSignal:
+		fmt.Fprintf(ob, "%012X%02X", f.ts, f.sig)
+	}
+
+	fmt.Fprintf(ob, "%X;", f.data.Bytes())
+
+	return ob.Bytes(), nil
+}
+
+// Bytes returns the stored message data.
+//
+// The returned slice remains valid until the next call to
+// UnmarshalText. Modifying the returned slice directly may impact
+// future Frame method calls.
+func (f *Frame) Bytes() []byte {
+	return f.data.Bytes()
+}
+
+// ModeAC returns the Mode A/C data, if the frame contains a 2 byte
+// message.
+//
+// The returned slice remains valid until the next call to
+// UnmarshalText. Modifying the returned slice directly may impact
+// future Frame method calls.
+func (f *Frame) ModeAC() ([]byte, error) {
+	if f.typ == 0 || f.data.Len() != 2 {
+		return nil, ErrNoData
+	}
+
+	return f.data.Bytes(), nil
+}
+
+// ModeS returns the Mode S data, if the frame contains a 7 or 14 byte
+// message. The result is suitable for adsb.RawMessage.UnmarshalBinary.
+//
+// The returned slice remains valid until the next call to
+// UnmarshalText. Modifying the returned slice directly may impact
+// future Frame method calls.
+func (f *Frame) ModeS() ([]byte, error) {
+	if f.typ == 0 || f.data.Len() == 2 {
+		return nil, ErrNoData
+	}
+
+	return f.data.Bytes(), nil
+}
+
+// Signal returns the signal level, if carried by the frame type.
+func (f *Frame) Signal() (uint8, error) {
+	if f.typ != TypeMLATSignal {
+		return 0, ErrNoData
+	}
+
+	return f.sig, nil
+}
+
+// Timestamp returns the MLAT timestamp as a time.Duration, if carried
+// by the frame type.
+func (f *Frame) Timestamp() (time.Duration, error) {
+	switch f.typ {
+	case TypeMLAT, TypeMLATNoCRC, TypeMLATSignal:
+	default:
+		return time.Duration(0), ErrNoData
+	}
+
+	return time.Duration(int64(f.ts) * 1000 / 12).Round(time.Microsecond / 2), nil
+}
+
+// Type returns the frame type byte.
+func (f *Frame) Type() (byte, error) {
+	if f.typ == 0 {
+		return 0, ErrNoData
+	}
+
+	return f.typ, nil
+}
+
+// set validates and stores the frame contents.
+func (f *Frame) set(typ byte, ts uint64, sig uint8, data []byte) error {
+	f.typ = 0
+	f.data.Reset()
+
+	switch len(data) {
+	case 2, 7, 14:
+	default:
+		return newErrorf(nil, "incorrect data length: %d bytes", len(data))
+	}
+
+	f.ts = 0
+	f.sig = 0
+
+	switch typ {
+	case TypeMLAT, TypeMLATNoCRC:
+		f.ts = ts
+	case TypeMLATSignal:
+		f.ts = ts
+		f.sig = sig
+	}
+
+	f.typ = typ
+	f.data.Write(data)
+
+	return nil
+}
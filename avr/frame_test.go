@@ -0,0 +1,218 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package avr_test
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NeuronInnovations/go-adsb/avr"
+)
+
+func TestInterfaces(t *testing.T) {
+	var f interface{} = new(avr.Frame)
+
+	if _, ok := f.(encoding.TextUnmarshaler); !ok {
+		t.Error("Frame does not support TextUnmarshaler")
+	}
+
+	if _, ok := f.(encoding.TextMarshaler); !ok {
+		t.Error("Frame does not support TextMarshaler")
+	}
+}
+
+func TestUnmarshalError(t *testing.T) {
+	for msg, e := range map[string]string{
+		"":                               "invalid data format: \"\"",
+		"*8D4840D6202CC371C32CE0576098":  "invalid data format: \"*8D4840D6202CC371C32CE0576098\"",
+		"#8D4840D6202CC371C32CE0576098;": "invalid frame type: '#'",
+		"*8D4840D6202CC371C32CE05760;":   "incorrect data length: 13 bytes",
+		"*8D4840D6202CC371C32CE057609Z;": "invalid message data: encoding/hex: invalid byte: U+005A 'Z'",
+		"@00000123;":                     "received truncated data",
+		"<00000123456ZFF5D4CA2D4A4A3C2;": "invalid timestamp: strconv.ParseUint: parsing \"00000123456Z\": invalid syntax",
+	} {
+		f := new(avr.Frame)
+
+		err := f.UnmarshalText([]byte(msg))
+		if err == nil {
+			t.Errorf("expected %s, received nil", e)
+		} else if err.Error() != e {
+			t.Errorf("expected %s, received %s", e, err.Error())
+		}
+	}
+}
+
+func TestNoDataErrors(t *testing.T) {
+	f := new(avr.Frame)
+
+	if _, err := f.MarshalText(); !errors.Is(err, avr.ErrNoData) {
+		t.Errorf("MarshalText: expected ErrNoData, received %v", err)
+	}
+
+	if _, err := f.ModeS(); !errors.Is(err, avr.ErrNoData) {
+		t.Errorf("ModeS: expected ErrNoData, received %v", err)
+	}
+
+	if _, err := f.ModeAC(); !errors.Is(err, avr.ErrNoData) {
+		t.Errorf("ModeAC: expected ErrNoData, received %v", err)
+	}
+
+	if _, err := f.Timestamp(); !errors.Is(err, avr.ErrNoData) {
+		t.Errorf("Timestamp: expected ErrNoData, received %v", err)
+	}
+
+	if _, err := f.Signal(); !errors.Is(err, avr.ErrNoData) {
+		t.Errorf("Signal: expected ErrNoData, received %v", err)
+	}
+
+	if _, err := f.Type(); !errors.Is(err, avr.ErrNoData) {
+		t.Errorf("Type: expected ErrNoData, received %v", err)
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	for _, tc := range []struct {
+		Name      string
+		Msg       string
+		Type      byte
+		Data      string
+		ModeAC    bool
+		Timestamp time.Duration
+		Signal    int
+	}{
+		{"Raw", "*8D4840D6202CC371C32CE0576098;", '*', "8d4840d6202cc371c32ce0576098", false, -1, -1},
+		{"Short", "*5DAA234A912889;", '*', "5daa234a912889", false, -1, -1},
+		{"ModeAC", "*5047;", '*', "5047", true, -1, -1},
+		{"MLAT", "@0000000000C08D4840D6202CC371C32CE0576098;", '@', "8d4840d6202cc371c32ce0576098", false, 16 * time.Microsecond, -1},
+		{"MLATNoCRC", "%0000000000C08D4840D6202CC371C32CE0576098;", '%', "8d4840d6202cc371c32ce0576098", false, 16 * time.Microsecond, -1},
+		{"Signal", "<0000000000C0C48D4840D6202CC371C32CE0576098;", '<', "8d4840d6202cc371c32ce0576098", false, 16 * time.Microsecond, 0xc4},
+		{"Whitespace", " *8d4840d6202cc371c32ce0576098;\r\n", '*', "8d4840d6202cc371c32ce0576098", false, -1, -1},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			f := new(avr.Frame)
+
+			err := f.UnmarshalText([]byte(tc.Msg))
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+
+			testFrame(t, f, tc.Type, tc.Data, tc.ModeAC, tc.Timestamp, tc.Signal)
+		})
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	data, err := hex.DecodeString("8d4840d6202cc371c32ce0576098")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	for typ, out := range map[byte]string{
+		avr.TypeRaw:        "*8D4840D6202CC371C32CE0576098;",
+		avr.TypeMLAT:       "@1A2B3C4D5E6F8D4840D6202CC371C32CE0576098;",
+		avr.TypeMLATNoCRC:  "%1A2B3C4D5E6F8D4840D6202CC371C32CE0576098;",
+		avr.TypeMLATSignal: "<1A2B3C4D5E6F0A8D4840D6202CC371C32CE0576098;",
+	} {
+		f, err := avr.NewFrame(typ, 0x1a2b3c4d5e6f, 0x0a, data)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		b, err := f.MarshalText()
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if string(b) != out {
+			t.Errorf("expected %s, received %s", out, b)
+		}
+	}
+}
+
+func TestNewFrameError(t *testing.T) {
+	if _, err := avr.NewFrame('#', 0, 0, make([]byte, 7)); err == nil {
+		t.Error("expected error for invalid type, received nil")
+	}
+
+	if _, err := avr.NewFrame(avr.TypeMLAT, 1<<48, 0, make([]byte, 7)); err == nil {
+		t.Error("expected error for invalid timestamp, received nil")
+	}
+
+	if _, err := avr.NewFrame(avr.TypeRaw, 0, 0, make([]byte, 8)); err == nil {
+		t.Error("expected error for invalid length, received nil")
+	}
+}
+
+func testFrame(t *testing.T, f *avr.Frame, typ byte, data string,
+	modeAC bool, ts time.Duration, sig int) {
+	t.Helper()
+
+	d, err := hex.DecodeString(data)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	ft, err := f.Type()
+	if err != nil || ft != typ {
+		t.Errorf("Type: expected %c, received %c %v", typ, ft, err)
+	}
+
+	if !bytes.Equal(f.Bytes(), d) {
+		t.Errorf("Bytes: expected %x, received %x", d, f.Bytes())
+	}
+
+	ac, acErr := f.ModeAC()
+	ms, msErr := f.ModeS()
+
+	if modeAC {
+		if acErr != nil || !bytes.Equal(ac, d) || !errors.Is(msErr, avr.ErrNoData) {
+			t.Errorf("ModeAC: expected %x, received %x %v", d, ac, acErr)
+		}
+	} else if msErr != nil || !bytes.Equal(ms, d) || !errors.Is(acErr, avr.ErrNoData) {
+		t.Errorf("ModeS: expected %x, received %x %v", d, ms, msErr)
+	}
+
+	rts, err := f.Timestamp()
+	if ts < 0 {
+		if !errors.Is(err, avr.ErrNoData) {
+			t.Errorf("Timestamp: expected ErrNoData, received %v", err)
+		}
+	} else if err != nil || rts != ts {
+		t.Errorf("Timestamp: expected %s, received %s %v", ts, rts, err)
+	}
+
+	rs, err := f.Signal()
+	if sig < 0 {
+		if !errors.Is(err, avr.ErrNoData) {
+			t.Errorf("Signal: expected ErrNoData, received %v", err)
+		}
+	} else if err != nil || int(rs) != sig {
+		t.Errorf("Signal: expected %d, received %d %v", sig, rs, err)
+	}
+}
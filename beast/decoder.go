@@ -69,8 +69,7 @@ func (d *Decoder) Decode(f encoding.BinaryUnmarshaler) error {
 		return readError(err)
 	}
 
-	if !(t[0] == 0x1a &&
-		(t[1] == 0x31 || t[1] == 0x32 || t[1] == 0x33 || t[1] == 0x34)) {
+	if _, ok := payloadLen[t[1]]; !(t[0] == 0x1a && ok) {
 		err = d.seekNext()
 		if err != nil {
 			return err
@@ -121,7 +120,7 @@ func (d *Decoder) seekNext() error {
 
 	var n int
 
-	for _, t := range []byte{0x31, 0x32, 0x33, 0x34} {
+	for t := range payloadLen {
 		nx := bytes.Index(b, []byte{0x1a, t})
 		if n == 0 && nx > 0 || nx > 0 && nx < n {
 			n = nx
@@ -168,7 +167,7 @@ func (d *Decoder) readMsg() error {
 
 		switch nb[1] {
 		// next frame, message is complete
-		case 0x31, 0x32, 0x33, 0x34:
+		case TypeModeAC, TypeModeSShort, TypeModeSLong, TypeStatus:
 			return nil
 		// escaped 0x1a, write and continue
 		case 0x1a:
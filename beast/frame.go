@@ -27,12 +27,62 @@ import (
 	"time"
 )
 
+// Frame types.
+const (
+	TypeModeAC     byte = 0x31 // Mode A/C reply
+	TypeModeSShort byte = 0x32 // 56 bit Mode S reply
+	TypeModeSLong  byte = 0x33 // 112 bit Mode S reply
+	TypeStatus     byte = 0x34 // receiver status
+)
+
+// payloadLen is the length of the data following the signal level for
+// each frame type.
+var payloadLen = map[byte]int{
+	TypeModeAC:     2,
+	TypeModeSShort: 7,
+	TypeModeSLong:  14,
+	TypeStatus:     14,
+}
+
 // Frame is a Beast format message. A Frame is safe to reuse by calling
 // UnmarshalBinary with new data.
 type Frame struct {
 	data bytes.Buffer
 }
 
+// NewFrame returns a Frame of type typ with the 48 bit MLAT timestamp ts,
+// in 12 MHz ticks, and signal level sig. The length of data must match
+// the frame type: 2 bytes of Mode A/C, 7 or 14 bytes of Mode S, or 14
+// bytes of receiver status. Any 0x1a values are escaped by
+// MarshalBinary.
+func NewFrame(typ byte, ts uint64, sig uint8, data []byte) (*Frame, error) {
+	n, ok := payloadLen[typ]
+	if !ok {
+		return nil, newErrorf(nil, "invalid frame type: %02x", typ)
+	}
+
+	if len(data) != n {
+		return nil, newErrorf(nil, "expected %d bytes of data, received %d", n, len(data))
+	}
+
+	if ts >= 1<<48 {
+		return nil, newErrorf(nil, "timestamp exceeds 48 bits: %d", ts)
+	}
+
+	f := new(Frame)
+
+	f.data.Grow(9 + n)
+	f.data.Write([]byte{
+		0x1a, typ,
+		byte(ts >> 40), byte(ts >> 32), byte(ts >> 24),
+		byte(ts >> 16), byte(ts >> 8), byte(ts),
+		sig,
+	})
+	f.data.Write(data)
+
+	return f, nil
+}
+
 // UnmarshalBinary stores a Beast message.
 func (f *Frame) UnmarshalBinary(data []byte) error {
 	f.data.Reset()
@@ -41,8 +91,8 @@ func (f *Frame) UnmarshalBinary(data []byte) error {
 		return newError(nil, "received truncated data")
 	}
 
-	if !(data[0] == 0x1a &&
-		(data[1] == 0x31 || data[1] == 0x32 || data[1] == 0x33 || data[1] == 0x34)) {
+	n, ok := payloadLen[data[1]]
+	if !(data[0] == 0x1a && ok) {
 		return newErrorf(nil, "invalid data format: %04x", data[0:2])
 	}
 
@@ -54,19 +104,8 @@ func (f *Frame) UnmarshalBinary(data []byte) error {
 		f.data.WriteByte(data[i])
 	}
 
-	switch data[1] {
-	case 0x31:
-		if f.data.Len() != 11 {
-			return newErrorf(nil, "expected 11 bytes, received %d", f.data.Len())
-		}
-	case 0x32:
-		if f.data.Len() != 16 {
-			return newErrorf(nil, "expected 16 bytes, received %d", f.data.Len())
-		}
-	case 0x33:
-		if f.data.Len() != 23 {
-			return newErrorf(nil, "expected 23 bytes, received %d", f.data.Len())
-		}
+	if f.data.Len() != 9+n {
+		return newErrorf(nil, "expected %d bytes, received %d", 9+n, f.data.Len())
 	}
 
 	return nil
@@ -114,7 +153,7 @@ func (f *Frame) ModeAC() ([]byte, error) {
 
 	b := f.data.Bytes()
 
-	if !(b[0] == 0x1a && b[1] == 0x31) {
+	if !(b[0] == 0x1a && b[1] == TypeModeAC) {
 		return nil, ErrNoData
 	}
 
@@ -133,7 +172,7 @@ func (f *Frame) ModeS() ([]byte, error) {
 
 	b := f.data.Bytes()
 
-	if !(b[0] == 0x1a && (b[1] == 0x32 || b[1] == 0x33)) {
+	if !(b[0] == 0x1a && (b[1] == TypeModeSShort || b[1] == TypeModeSLong)) {
 		return nil, ErrNoData
 	}
 
@@ -61,6 +61,7 @@ func TestUnmarshalError(t *testing.T) {
 	t.Run("BadLength1", testUnmarshalBadLength1)
 	t.Run("BadLength2", testUnmarshalBadLength2)
 	t.Run("BadLength3", testUnmarshalBadLength3)
+	t.Run("BadLength4", testUnmarshalBadLength4)
 	t.Run("BadEscape", testUnmarshalBadEscape)
 	t.Run("BadType", testUnmarshalBadType)
 	t.Run("NoType", testUnmarshalNoType)
@@ -82,6 +83,10 @@ func testUnmarshalBadLength3(t *testing.T) {
 	testUnmarshalError(t, "1a33ffffffffffffffffffffffffffffffffffffffffffffff", "expected 23 bytes, received 25")
 }
 
+func testUnmarshalBadLength4(t *testing.T) {
+	testUnmarshalError(t, "1a34ffffffffffffff00ffffff", "expected 23 bytes, received 13")
+}
+
 func testUnmarshalBadEscape(t *testing.T) {
 	testUnmarshalError(t, "1a31ffffffffffffffffffffffffff1a", "expected 11 bytes, received 16")
 }
@@ -425,3 +430,96 @@ func testUnmarshalType(t *testing.T) {
 		t.Errorf("expected %c, received %c", ftr, rt)
 	}
 }
+
+func TestNewFrame(t *testing.T) {
+	t.Run("Marshal", testNewFrameMarshal)
+	t.Run("ModeAC", testNewFrameModeAC)
+	t.Run("Errors", testNewFrameErrors)
+}
+
+func testNewFrameMarshal(t *testing.T) {
+	msg, err := hex.DecodeString("1a321a1af933baf325c45da99adad95ff6")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	data, err := hex.DecodeString("5da99adad95ff6")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	f, err := beast.NewFrame(beast.TypeModeSShort, 0x1af933baf325, 0xc4, data)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	rm, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !bytes.Equal(msg, rm) {
+		t.Errorf("expected %x, received %x", msg, rm)
+	}
+
+	rf := new(beast.Frame)
+
+	err = rf.UnmarshalBinary(rm)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !bytes.Equal(f.Bytes(), rf.Bytes()) {
+		t.Errorf("expected %x, received %x", f.Bytes(), rf.Bytes())
+	}
+}
+
+func testNewFrameModeAC(t *testing.T) {
+	f, err := beast.NewFrame(beast.TypeModeAC, 0, 0x1a, []byte{0x1a, 0x01})
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	rm, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	exp := "1a310000000000001a1a1a1a01"
+	if hex.EncodeToString(rm) != exp {
+		t.Errorf("expected %s, received %x", exp, rm)
+	}
+
+	ac, err := f.ModeAC()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !bytes.Equal(ac, []byte{0x1a, 0x01}) {
+		t.Errorf("expected 1a01, received %x", ac)
+	}
+}
+
+func testNewFrameErrors(t *testing.T) {
+	for _, tc := range []struct {
+		typ  byte
+		ts   uint64
+		data []byte
+		e    string
+	}{
+		{0x35, 0, make([]byte, 14), "invalid frame type: 35"},
+		{beast.TypeModeSShort, 0, make([]byte, 14), "expected 7 bytes of data, received 14"},
+		{beast.TypeModeSLong, 1 << 48, make([]byte, 14), "timestamp exceeds 48 bits: 281474976710656"},
+	} {
+		f, err := beast.NewFrame(tc.typ, tc.ts, 0, tc.data)
+		if err == nil {
+			t.Errorf("expected %s, received nil", tc.e)
+		} else if err.Error() != tc.e {
+			t.Errorf("expected %s, received %s", tc.e, err.Error())
+		}
+
+		if f != nil {
+			t.Errorf("expected nil, received %x", f.Bytes())
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package beast
+
+// Settings is the receiver configuration reported in a type 4 frame. On
+// the Mode-S Beast each bit reflects the position of a DIP switch.
+type Settings uint8
+
+// Receiver settings.
+const (
+	SettingBinary       Settings = 1 << iota // Beast binary output, otherwise AVR
+	SettingDFFilter                          // only DF11, DF17 and DF18 are output
+	SettingMLAT                              // MLAT timestamps included in AVR output
+	SettingNoCRC                             // CRC checks disabled
+	SettingGPSTimestamp                      // timestamps from GPS, otherwise 12 MHz counter
+	SettingRTSHandshake                      // RTS handshake enabled
+	SettingNoFEC                             // error correction disabled
+	SettingModeAC                            // Mode A/C replies output
+)
+
+// Has reports whether all of the bits in v are set.
+func (s Settings) Has(v Settings) bool {
+	return s&v == v
+}
+
+// GPSStatus is the GPS receiver state reported in a type 4 frame by
+// Radarcape compatible receivers.
+type GPSStatus uint8
+
+// GPS status bits.
+const (
+	GPSEmulated GPSStatus = 0x20 // timestamps are not synchronized to GPS
+	GPSUTCFix   GPSStatus = 0x80 // timestamps are corrected to UTC
+)
+
+// Has reports whether all of the bits in v are set.
+func (s GPSStatus) Has(v GPSStatus) bool {
+	return s&v == v
+}
+
+// Locked reports whether the timestamps are synchronized to GPS.
+func (s GPSStatus) Locked() bool {
+	return !s.Has(GPSEmulated)
+}
+
+// status returns the data in a type 4 frame.
+func (f *Frame) status() ([]byte, error) {
+	if f.data.Len() != 9+payloadLen[TypeStatus] {
+		return nil, ErrNoData
+	}
+
+	b := f.data.Bytes()
+
+	if !(b[0] == 0x1a && b[1] == TypeStatus) {
+		return nil, ErrNoData
+	}
+
+	return b[9:], nil
+}
+
+// Settings returns the receiver settings in a type 4 frame.
+func (f *Frame) Settings() (Settings, error) {
+	b, err := f.status()
+	if err != nil {
+		return 0, err
+	}
+
+	return Settings(b[0]), nil
+}
+
+// TimestampError returns the difference in 12 MHz ticks between the
+// receiver clock and the GPS time pulse in a type 4 frame.
+func (f *Frame) TimestampError() (int8, error) {
+	b, err := f.status()
+	if err != nil {
+		return 0, err
+	}
+
+	return int8(b[1]), nil
+}
+
+// GPSStatus returns the GPS status in a type 4 frame.
+func (f *Frame) GPSStatus() (GPSStatus, error) {
+	b, err := f.status()
+	if err != nil {
+		return 0, err
+	}
+
+	return GPSStatus(b[2]), nil
+}
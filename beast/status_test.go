@@ -0,0 +1,137 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package beast_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/NeuronInnovations/go-adsb/beast"
+)
+
+func TestStatus(t *testing.T) {
+	t.Run("Locked", testStatusLocked)
+	t.Run("Emulated", testStatusEmulated)
+	t.Run("NoData", testStatusNoData)
+}
+
+func testStatusLocked(t *testing.T) {
+	f := testStatusFrame(t, "1a3400000000000000511a1a800000000000000000000000")
+
+	s, err := f.Settings()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !s.Has(beast.SettingBinary|beast.SettingGPSTimestamp|beast.SettingNoFEC) ||
+		s.Has(beast.SettingModeAC) || s.Has(beast.SettingNoCRC) {
+		t.Errorf("received unexpected settings %08b", s)
+	}
+
+	te, err := f.TimestampError()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if te != 26 {
+		t.Errorf("expected 26, received %d", te)
+	}
+
+	g, err := f.GPSStatus()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !g.Locked() || !g.Has(beast.GPSUTCFix) {
+		t.Errorf("received unexpected GPS status %08b", g)
+	}
+}
+
+func testStatusEmulated(t *testing.T) {
+	f := testStatusFrame(t, "1a34000000000000008bfe200000000000000000000000")
+
+	s, err := f.Settings()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if !s.Has(beast.SettingModeAC) || s.Has(beast.SettingGPSTimestamp) {
+		t.Errorf("received unexpected settings %08b", s)
+	}
+
+	te, err := f.TimestampError()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if te != -2 {
+		t.Errorf("expected -2, received %d", te)
+	}
+
+	g, err := f.GPSStatus()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if g.Locked() || g.Has(beast.GPSUTCFix) {
+		t.Errorf("received unexpected GPS status %08b", g)
+	}
+}
+
+func testStatusNoData(t *testing.T) {
+	f := testStatusFrame(t, "1a321a1af933baf325c45da99adad95ff6")
+
+	_, err := f.Settings()
+	if !errors.Is(err, beast.ErrNoData) {
+		t.Errorf("expected %s, received %v", beast.ErrNoData, err)
+	}
+
+	_, err = f.TimestampError()
+	if !errors.Is(err, beast.ErrNoData) {
+		t.Errorf("expected %s, received %v", beast.ErrNoData, err)
+	}
+
+	_, err = new(beast.Frame).GPSStatus()
+	if !errors.Is(err, beast.ErrNoData) {
+		t.Errorf("expected %s, received %v", beast.ErrNoData, err)
+	}
+}
+
+func testStatusFrame(t *testing.T, msg string) *beast.Frame {
+	t.Helper()
+
+	b, err := hex.DecodeString(msg)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	f := new(beast.Frame)
+
+	err = f.UnmarshalBinary(b)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	return f
+}
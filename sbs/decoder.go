@@ -0,0 +1,112 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sbs
+
+import (
+	"bufio"
+	"bytes"
+	"encoding"
+	"errors"
+	"io"
+)
+
+// Decoder reads a BaseStation stream and stores individual lines. It
+// must be created with NewDecoder().
+type Decoder struct {
+	r *bufio.Reader
+}
+
+// NewDecoder returns a Decoder which reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	d := new(Decoder)
+	d.r = bufio.NewReader(r)
+
+	return d
+}
+
+// Decode reads the next non-empty line from the input source and
+// stores it in f. The data passed to f remains valid only until the
+// next call to Decode().
+func (d *Decoder) Decode(f encoding.TextUnmarshaler) error {
+	for {
+		b, err := d.r.ReadSlice('\n')
+
+		b = bytes.TrimSpace(b)
+		if len(b) == 0 {
+			if err != nil {
+				return readError(err)
+			}
+
+			continue
+		}
+
+		if err != nil && !errors.Is(err, io.EOF) {
+			return readError(err)
+		}
+
+		err = f.UnmarshalText(b)
+		if err != nil {
+			return newError(err, "error unmarshalling data")
+		}
+
+		return nil
+	}
+}
+
+// Encoder writes a BaseStation stream. It must be created with
+// NewEncoder().
+type Encoder struct {
+	w io.Writer
+}
+
+// NewEncoder returns an Encoder which writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	e := new(Encoder)
+	e.w = w
+
+	return e
+}
+
+// Encode writes the BaseStation line for f, followed by CR LF, to the
+// output stream.
+func (e *Encoder) Encode(f encoding.TextMarshaler) error {
+	b, err := f.MarshalText()
+	if err != nil {
+		return newError(err, "error marshalling data")
+	}
+
+	_, err = e.w.Write(append(b, '\r', '\n'))
+	if err != nil {
+		return newError(err, "error writing stream")
+	}
+
+	return nil
+}
+
+// readError returns a read error.
+func readError(w error) sbsError {
+	return sbsError{
+		msg:  "error reading stream",
+		werr: w,
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sbs_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/NeuronInnovations/go-adsb/sbs"
+)
+
+const testStream = "MSG,1,1,1,ACF84E,1,2020/01/02,03:04:05.678,2020/01/02,03:04:05.678,DAL2332,,,,,,,,,,,\r\n" +
+	"\r\n" +
+	"MSG,5,1,1,A27AEE,1,2020/01/02,03:04:05.678,2020/01/02,03:04:05.678,,39000,,,,,,,0,,0,0\r\n" +
+	"MSG,8,1,1,AC22C5,1,2020/01/02,03:04:05.678,2020/01/02,03:04:05.678,,,,,,,,,,,,0"
+
+func TestDecodeEncode(t *testing.T) {
+	d := sbs.NewDecoder(strings.NewReader(testStream))
+	r := new(sbs.Record)
+
+	var out bytes.Buffer
+
+	e := sbs.NewEncoder(&out)
+
+	var types []sbs.TransmissionType
+
+	for {
+		err := d.Decode(r)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		types = append(types, r.Type)
+
+		err = e.Encode(r)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+	}
+
+	if len(types) != 3 || types[0] != sbs.MSG1 || types[1] != sbs.MSG5 || types[2] != sbs.MSG8 {
+		t.Errorf("received unexpected types %v", types)
+	}
+
+	exp := strings.Replace(testStream, "\r\n\r\n", "\r\n", 1) + "\r\n"
+	if out.String() != exp {
+		t.Errorf("expected %q, received %q", exp, out.String())
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	d := sbs.NewDecoder(strings.NewReader("MSG,1\n"))
+
+	err := d.Decode(new(sbs.Record))
+	if err == nil || err.Error() != "error unmarshalling data: expected 22 fields, received 2" {
+		t.Errorf("received unexpected error %v", err)
+	}
+}
+
+func TestTransmissionType(t *testing.T) {
+	if sbs.MSG3.String() != "ES airborne position" {
+		t.Errorf("received unexpected string %s", sbs.MSG3)
+	}
+
+	if sbs.TransmissionType(99).String() != "Unknown value 99" {
+		t.Errorf("received unexpected string %s", sbs.TransmissionType(99))
+	}
+}
@@ -0,0 +1,444 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sbs
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NeuronInnovations/go-adsb/adsb"
+	"github.com/NeuronInnovations/go-adsb/tracker"
+)
+
+// Layouts of the date and time fields.
+const (
+	dateLayout = "2006/01/02"
+	timeLayout = "15:04:05.000"
+)
+
+// Record is a BaseStation MSG line. Optional fields are nil or empty
+// when not present.
+type Record struct {
+	Type TransmissionType
+
+	SessionID  string
+	AircraftID string
+	FlightID   string
+
+	ICAO uint64
+
+	Generated time.Time // time the message was generated
+	Logged    time.Time // time the message was logged
+
+	Callsign     string
+	Altitude     *int64   // altitude in feet
+	GroundSpeed  *float64 // ground speed in knots
+	Track        *float64 // track in degrees clockwise from north
+	Lat          *float64 // latitude in degrees
+	Lon          *float64 // longitude in degrees
+	VerticalRate *int64   // vertical rate in feet per minute
+	Squawk       string   // four digit Mode A code
+
+	Alert     *bool // squawk has changed
+	Emergency *bool // emergency squawk code
+	SPI       *bool // special position identification
+	OnGround  *bool // aircraft is on the ground
+}
+
+// NewRecord returns a Record for m, received at ts, with the
+// transmission type chosen from the downlink format and type code. If
+// a is not nil, it must be the state returned by tracker.Update for m,
+// and is used to supply the position for MSG2 and MSG3 records and the
+// squawk for the emergency flag.
+//
+// If m has no BaseStation representation, the returned error wraps
+// ErrUnsupported.
+func NewRecord(m *adsb.Message, a *tracker.Aircraft, ts time.Time) (*Record, error) {
+	icao, err := m.ICAO()
+	if err != nil {
+		return nil, newError(err, "error creating record")
+	}
+
+	df, err := m.Raw().DF()
+	if err != nil {
+		return nil, newError(err, "error creating record")
+	}
+
+	r := &Record{
+		SessionID:  "1",
+		AircraftID: "1",
+		ICAO:       icao,
+		FlightID:   "1",
+		Generated:  ts,
+		Logged:     ts,
+	}
+
+	switch df {
+	case 17, 18:
+		err = r.setES(m, a, ts)
+	case 4, 20:
+		r.Type = MSG5
+		r.setAlt(m)
+		r.setFS(m)
+	case 5, 21:
+		r.Type = MSG6
+		r.setSqk(m)
+		r.setFS(m)
+	case 0, 16:
+		r.Type = MSG7
+		r.setAlt(m)
+
+		if vs, err := m.Raw().VS(); err == nil {
+			r.OnGround = boolPtr(vs == 1)
+		}
+	case 11:
+		r.Type = MSG8
+
+		if ca, err := m.Raw().CA(); err == nil && (ca == 4 || ca == 5) {
+			r.OnGround = boolPtr(ca == 4)
+		}
+	default:
+		err = newErrorf(ErrUnsupported, "downlink format %d", df)
+	}
+
+	if err != nil {
+		return nil, newError(err, "error creating record")
+	}
+
+	if r.Emergency == nil && (r.Squawk != "" || a != nil && a.Squawk != "") {
+		sqk := r.Squawk
+		if sqk == "" {
+			sqk = a.Squawk
+		}
+
+		r.Emergency = boolPtr(sqk == "7500" || sqk == "7600" || sqk == "7700")
+	}
+
+	return r, nil
+}
+
+// setES populates the record from an extended squitter message.
+func (r *Record) setES(m *adsb.Message, a *tracker.Aircraft, ts time.Time) error {
+	tc, err := m.Raw().ESType()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case tc >= 1 && tc <= 4:
+		r.Type = MSG1
+
+		if call, err := m.Call(); err == nil {
+			r.Callsign = call
+		}
+	case tc >= 5 && tc <= 8:
+		r.Type = MSG2
+		r.OnGround = boolPtr(true)
+
+		if spd, trk, err := m.SurfaceSpeed(); err == nil {
+			r.GroundSpeed = floatPtr(spd / adsb.KNOT_TO_MPS)
+			r.Track = floatPtr(trk)
+		}
+
+		r.setPosition(a, ts)
+	case tc >= 9 && tc <= 18, tc >= 20 && tc <= 22:
+		r.Type = MSG3
+		r.OnGround = boolPtr(false)
+		r.setAlt(m)
+
+		// surveillance status
+		ss := m.Raw().Bits(38, 39)
+		r.Alert = boolPtr(ss == 1 || ss == 2)
+		r.SPI = boolPtr(ss == 3)
+
+		r.setPosition(a, ts)
+	case tc == 19:
+		r.Type = MSG4
+
+		if spd, trk, err := m.GroundSpeed(); err == nil {
+			r.GroundSpeed = floatPtr(spd / adsb.KNOT_TO_MPS)
+			r.Track = floatPtr(math.Mod(trk+360, 360))
+		}
+
+		if vr, err := m.VerticalSpeed(); err == nil {
+			r.VerticalRate = intPtr(int64(math.Round(vr / adsb.FEET_PER_MIN_TO_MPS)))
+		}
+	default:
+		return newErrorf(ErrUnsupported, "type code %d", tc)
+	}
+
+	return nil
+}
+
+// setAlt sets the altitude if available. Positions with type code 20 to
+// 22 carry GNSS height in place of barometric altitude.
+func (r *Record) setAlt(m *adsb.Message) {
+	if alt, err := m.Alt(); err == nil {
+		r.Altitude = intPtr(alt)
+	} else if _, ft, err := m.GeometricAlt(); err == nil {
+		r.Altitude = intPtr(ft)
+	}
+}
+
+// setSqk sets the squawk if available.
+func (r *Record) setSqk(m *adsb.Message) {
+	if sqk, err := m.Sqk(); err == nil {
+		r.Squawk = string([]byte{'0' + sqk[0], '0' + sqk[1], '0' + sqk[2], '0' + sqk[3]})
+	}
+}
+
+// setFS sets the alert, SPI and ground flags from the flight status.
+func (r *Record) setFS(m *adsb.Message) {
+	fs, err := m.Raw().FS()
+	if err != nil {
+		return
+	}
+
+	r.Alert = boolPtr(fs == 2 || fs == 3 || fs == 4)
+	r.SPI = boolPtr(fs == 4 || fs == 5)
+
+	switch fs {
+	case 0, 2:
+		r.OnGround = boolPtr(false)
+	case 1, 3:
+		r.OnGround = boolPtr(true)
+	}
+}
+
+// setPosition sets the position if it was resolved from the message.
+func (r *Record) setPosition(a *tracker.Aircraft, ts time.Time) {
+	if a == nil || !a.PositionTime.Equal(ts) {
+		return
+	}
+
+	r.Lat = floatPtr(a.Lat)
+	r.Lon = floatPtr(a.Lon)
+}
+
+// MarshalText returns the BaseStation line for the record, without a
+// line ending.
+func (r *Record) MarshalText() ([]byte, error) {
+	if r.Type < MSG1 || r.Type > MSG8 {
+		return nil, newErrorf(ErrUnsupported, "transmission type %d", r.Type)
+	}
+
+	f := make([]string, 22)
+	f[0] = "MSG"
+	f[1] = strconv.Itoa(int(r.Type))
+	f[2] = r.SessionID
+	f[3] = r.AircraftID
+	f[4] = fmt.Sprintf("%06X", r.ICAO)
+	f[5] = r.FlightID
+
+	if !r.Generated.IsZero() {
+		f[6] = r.Generated.Format(dateLayout)
+		f[7] = r.Generated.Format(timeLayout)
+	}
+
+	if !r.Logged.IsZero() {
+		f[8] = r.Logged.Format(dateLayout)
+		f[9] = r.Logged.Format(timeLayout)
+	}
+
+	f[10] = r.Callsign
+	f[11] = formatInt(r.Altitude)
+	f[12] = formatFloat(r.GroundSpeed, 0)
+	f[13] = formatFloat(r.Track, 0)
+	f[14] = formatFloat(r.Lat, 5)
+	f[15] = formatFloat(r.Lon, 5)
+	f[16] = formatInt(r.VerticalRate)
+	f[17] = r.Squawk
+	f[18] = formatBool(r.Alert)
+	f[19] = formatBool(r.Emergency)
+	f[20] = formatBool(r.SPI)
+	f[21] = formatBool(r.OnGround)
+
+	return []byte(strings.Join(f, ",")), nil
+}
+
+// UnmarshalText parses a BaseStation MSG line into the record. Dates
+// and times are interpreted in the local time zone. Lines of other
+// message types return an error wrapping ErrUnsupported.
+func (r *Record) UnmarshalText(text []byte) error {
+	*r = Record{}
+
+	f := strings.Split(string(bytes.TrimSpace(text)), ",")
+
+	if f[0] != "MSG" {
+		return newErrorf(ErrUnsupported, "message type %s", f[0])
+	}
+
+	if len(f) != 22 {
+		return newErrorf(nil, "expected 22 fields, received %d", len(f))
+	}
+
+	p := &parser{f: f}
+
+	r.Type = TransmissionType(p.uint(1, 10, 8))
+	r.SessionID = f[2]
+	r.AircraftID = f[3]
+	r.ICAO = p.uint(4, 16, 24)
+	r.FlightID = f[5]
+	r.Generated = p.time(6, 7)
+	r.Logged = p.time(8, 9)
+	r.Callsign = strings.TrimSpace(f[10])
+	r.Altitude = p.int(11)
+	r.GroundSpeed = p.float(12)
+	r.Track = p.float(13)
+	r.Lat = p.float(14)
+	r.Lon = p.float(15)
+	r.VerticalRate = p.int(16)
+	r.Squawk = f[17]
+	r.Alert = p.bool(18)
+	r.Emergency = p.bool(19)
+	r.SPI = p.bool(20)
+	r.OnGround = p.bool(21)
+
+	if p.err != nil {
+		return p.err
+	}
+
+	if r.Type < MSG1 || r.Type > MSG8 {
+		return newErrorf(ErrUnsupported, "transmission type %d", r.Type)
+	}
+
+	return nil
+}
+
+// parser converts fields, retaining the first error encountered.
+type parser struct {
+	f   []string
+	err error
+}
+
+func (p *parser) fail(n int, err error) {
+	if p.err == nil {
+		p.err = newErrorf(err, "invalid field %d", n+1)
+	}
+}
+
+func (p *parser) uint(n int, base int, bits int) uint64 {
+	v, err := strconv.ParseUint(p.f[n], base, bits)
+	if err != nil {
+		p.fail(n, err)
+	}
+
+	return v
+}
+
+func (p *parser) int(n int) *int64 {
+	if p.f[n] == "" {
+		return nil
+	}
+
+	v, err := strconv.ParseFloat(p.f[n], 64)
+	if err != nil {
+		p.fail(n, err)
+
+		return nil
+	}
+
+	return intPtr(int64(math.Round(v)))
+}
+
+func (p *parser) float(n int) *float64 {
+	if p.f[n] == "" {
+		return nil
+	}
+
+	v, err := strconv.ParseFloat(p.f[n], 64)
+	if err != nil {
+		p.fail(n, err)
+
+		return nil
+	}
+
+	return &v
+}
+
+func (p *parser) bool(n int) *bool {
+	switch p.f[n] {
+	case "":
+		return nil
+	case "0":
+		return boolPtr(false)
+	case "-1", "1":
+		return boolPtr(true)
+	default:
+		p.fail(n, newErrorf(nil, "invalid flag %q", p.f[n]))
+
+		return nil
+	}
+}
+
+func (p *parser) time(d int, t int) time.Time {
+	if p.f[d] == "" && p.f[t] == "" {
+		return time.Time{}
+	}
+
+	// fractional seconds are accepted without being in the layout
+	v, err := time.ParseInLocation(dateLayout+" 15:04:05", p.f[d]+" "+p.f[t], time.Local)
+	if err != nil {
+		p.fail(d, err)
+	}
+
+	return v
+}
+
+// formatInt returns the string value of an optional integer.
+func formatInt(v *int64) string {
+	if v == nil {
+		return ""
+	}
+
+	return strconv.FormatInt(*v, 10)
+}
+
+// formatFloat returns the string value of an optional float.
+func formatFloat(v *float64, prec int) string {
+	if v == nil {
+		return ""
+	}
+
+	return strconv.FormatFloat(*v, 'f', prec, 64)
+}
+
+// formatBool returns the BaseStation value of an optional flag.
+func formatBool(v *bool) string {
+	switch {
+	case v == nil:
+		return ""
+	case *v:
+		return "-1"
+	default:
+		return "0"
+	}
+}
+
+func boolPtr(v bool) *bool        { return &v }
+func intPtr(v int64) *int64       { return &v }
+func floatPtr(v float64) *float64 { return &v }
@@ -0,0 +1,216 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sbs_test
+
+import (
+	"encoding"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NeuronInnovations/go-adsb/adsb"
+	"github.com/NeuronInnovations/go-adsb/sbs"
+	"github.com/NeuronInnovations/go-adsb/tracker"
+)
+
+var t0 = time.Date(2020, 1, 2, 3, 4, 5, 678000000, time.Local)
+
+func TestInterfaces(t *testing.T) {
+	var r interface{} = new(sbs.Record)
+
+	if _, ok := r.(encoding.TextUnmarshaler); !ok {
+		t.Error("Record does not support TextUnmarshaler")
+	}
+
+	if _, ok := r.(encoding.TextMarshaler); !ok {
+		t.Error("Record does not support TextMarshaler")
+	}
+}
+
+func TestNewRecord(t *testing.T) {
+	const dt = "2020/01/02,03:04:05.678,2020/01/02,03:04:05.678"
+
+	for _, tc := range []struct {
+		Name string
+		Msg  string
+		Line string
+	}{
+		{"MSG1", "8dacf84e23101332cf3ca037ef13",
+			"MSG,1,1,1,ACF84E,1," + dt + ",DAL2332,,,,,,,,,,,"},
+		{"MSG3GNSS", "8da80287a07fa028de0786469665",
+			"MSG,3,1,1,A80287,1," + dt + ",,6699,,,,,,,0,,0,0"},
+		{"MSG4", "8dc054bd9908dc85986c0c2ebe76",
+			"MSG,4,1,1,C054BD,1," + dt + ",,,223,101,,,-1664,,,,,"},
+		{"MSG5", "20001910bc45e9",
+			"MSG,5,1,1,A27AEE,1," + dt + ",,39000,,,,,,,0,,0,0"},
+		{"MSG6", "28001b0601970d",
+			"MSG,6,1,1,A3696E,1," + dt + ",,,,,,,,3452,0,0,0,0"},
+		{"MSG7", "02e19718e70f6c",
+			"MSG,7,1,1,ABD94D,1," + dt + ",,36000,,,,,,,,,,0"},
+		{"MSG8", "5dac22c54b7a07",
+			"MSG,8,1,1,AC22C5,1," + dt + ",,,,,,,,,,,,0"},
+	} {
+		tc := tc
+
+		t.Run(tc.Name, func(t *testing.T) {
+			r, err := sbs.NewRecord(message(t, tc.Msg), nil, t0)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+
+			testMarshal(t, r, tc.Line)
+		})
+	}
+}
+
+func TestNewRecordPosition(t *testing.T) {
+	tr := tracker.NewTracker()
+
+	m := message(t, "8da8028758ab0028de078689d437")
+
+	a, err := tr.Update(m, t0.Add(-time.Second))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	r, err := sbs.NewRecord(m, &a, t0.Add(-time.Second))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if r.Lat != nil || r.Lon != nil {
+		t.Error("position populated without a resolved position")
+	}
+
+	m = message(t, "8da8028758ab07b0b8876e81eb25")
+
+	a, err = tr.Update(m, t0)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	r, err = sbs.NewRecord(m, &a, t0)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	testMarshal(t, r, "MSG,3,1,1,A80287,1,2020/01/02,03:04:05.678,2020/01/02,03:04:05.678,,"+
+		"33000,,,42.23945,-89.87851,,,0,,0,0")
+}
+
+func TestNewRecordUnsupported(t *testing.T) {
+	// DF24 Comm-D
+	_, err := sbs.NewRecord(message(t, "c4576da66a68295e7d22ed5dd112"), nil, t0)
+	if !errors.Is(err, sbs.ErrUnsupported) {
+		t.Errorf("expected ErrUnsupported, received %v", err)
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	line := "MSG,3,5,211,4CA2D6,10057,2020/01/02,03:04:05.678,2020/01/02,03:04:05.700,," +
+		"37000,,,51.45735,-1.02826,,,0,0,0,0"
+
+	r := new(sbs.Record)
+
+	err := r.UnmarshalText([]byte(line))
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if r.Type != sbs.MSG3 || r.ICAO != 0x4ca2d6 || r.SessionID != "5" || r.FlightID != "10057" {
+		t.Errorf("received unexpected header %+v", r)
+	}
+
+	if !r.Generated.Equal(t0) || !r.Logged.Equal(t0.Add(22*time.Millisecond)) {
+		t.Errorf("received unexpected times %s, %s", r.Generated, r.Logged)
+	}
+
+	if r.Altitude == nil || *r.Altitude != 37000 {
+		t.Errorf("Altitude: received %v, expected 37000", r.Altitude)
+	}
+
+	if r.Lat == nil || *r.Lat != 51.45735 || r.Lon == nil || *r.Lon != -1.02826 {
+		t.Errorf("received unexpected position %v, %v", r.Lat, r.Lon)
+	}
+
+	if r.GroundSpeed != nil || r.Track != nil || r.VerticalRate != nil || r.Squawk != "" {
+		t.Error("received unexpected optional fields")
+	}
+
+	if r.OnGround == nil || *r.OnGround {
+		t.Errorf("OnGround: received %v, expected false", r.OnGround)
+	}
+
+	testMarshal(t, r, line)
+}
+
+func TestUnmarshalError(t *testing.T) {
+	for line, e := range map[string]string{
+		"MSG,3,1,1,4CA2D6,1":                  "expected 22 fields, received 6",
+		"MSG,9,1,1,4CA2D6,1,,,,,,,,,,,,,,,,":  "transmission type 9: format unsupported",
+		"MSG,3,1,1,4CA2DZ,1,,,,,,,,,,,,,,,,":  "invalid field 5: strconv.ParseUint: parsing \"4CA2DZ\": invalid syntax",
+		"MSG,3,1,1,4CA2D6,1,,,,,,,,,,,,,,,,2": "invalid field 22: invalid flag \"2\"",
+		"SEL,,496,2286,4CA4E5,27215,2010/02/19,18:06:07.710,2010/02/19,18:06:07.710,RYR1427": "message type SEL: format unsupported",
+	} {
+		r := new(sbs.Record)
+
+		err := r.UnmarshalText([]byte(line))
+		if err == nil {
+			t.Errorf("expected %s, received nil", e)
+		} else if err.Error() != e {
+			t.Errorf("expected %s, received %s", e, err.Error())
+		}
+	}
+}
+
+func message(t *testing.T, s string) *adsb.Message {
+	t.Helper()
+
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	m := new(adsb.Message)
+
+	err = m.UnmarshalBinary(b)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	return m
+}
+
+func testMarshal(t *testing.T, r *sbs.Record, line string) {
+	t.Helper()
+
+	b, err := r.MarshalText()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if string(b) != line {
+		t.Errorf("expected %s\n received %s", line, b)
+	}
+}
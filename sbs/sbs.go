@@ -0,0 +1,109 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package sbs provides objects and methods for encoding and decoding
+// messages in the SBS-1 BaseStation format, as provided by dump1090 on
+// port 30003.
+package sbs
+
+import (
+	"fmt"
+)
+
+// sbsError is the error type for the sbs library.
+type sbsError struct {
+	msg  string // error message string from this library
+	werr error  // wrapped error from downstream function
+}
+
+// Error returns the string value of an error.
+func (e sbsError) Error() string {
+	if e.werr == nil {
+		return e.msg
+	}
+
+	return e.msg + ": " + e.werr.Error()
+}
+
+// Unwrap returns an underlying error if applicable.
+func (e sbsError) Unwrap() error {
+	return e.werr
+}
+
+// newError returns a new sbsError.
+func newError(w error, m string) sbsError {
+	return sbsError{
+		msg:  m,
+		werr: w,
+	}
+}
+
+// newErrorf returns a new sbsError with a Printf-style message.
+func newErrorf(w error, m string, v ...interface{}) sbsError {
+	return sbsError{
+		msg:  fmt.Sprintf(m, v...),
+		werr: w,
+	}
+}
+
+var errUnsupported = newError(nil, "format unsupported")
+
+// ErrUnsupported is returned when a message has no BaseStation
+// representation, or a BaseStation line is not a supported message
+// type. The error may be wrapped and should be checked with
+// errors.Is().
+var ErrUnsupported = errUnsupported
+
+// TransmissionType is the BaseStation MSG transmission type.
+type TransmissionType uint8
+
+// Transmission Type values.
+const (
+	MSG1 TransmissionType = 1 // ES identification and category
+	MSG2 TransmissionType = 2 // ES surface position
+	MSG3 TransmissionType = 3 // ES airborne position
+	MSG4 TransmissionType = 4 // ES airborne velocity
+	MSG5 TransmissionType = 5 // Surveillance altitude
+	MSG6 TransmissionType = 6 // Surveillance identity
+	MSG7 TransmissionType = 7 // Air-to-air
+	MSG8 TransmissionType = 8 // All-call reply
+)
+
+var mTransmissionType = map[TransmissionType]string{
+	MSG1: "ES identification and category",
+	MSG2: "ES surface position",
+	MSG3: "ES airborne position",
+	MSG4: "ES airborne velocity",
+	MSG5: "Surveillance altitude",
+	MSG6: "Surveillance identity",
+	MSG7: "Air-to-air",
+	MSG8: "All-call reply",
+}
+
+// String representation of TransmissionType.
+func (c TransmissionType) String() string {
+	if str, ok := mTransmissionType[c]; ok {
+		return str
+	}
+
+	return fmt.Sprintf("Unknown value %d", c)
+}
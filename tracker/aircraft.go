@@ -0,0 +1,172 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tracker
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/NeuronInnovations/go-adsb/adsb"
+)
+
+// Aircraft is the combined state of a single aircraft. Each value is
+// only meaningful if its corresponding time is not zero.
+type Aircraft struct {
+	ICAO uint64 // ICAO address
+
+	Call     string    // callsign
+	Category string    // emitter category description
+	CallTime time.Time // time Call or Category was last updated
+
+	Squawk     string    // four digit Mode A code
+	SquawkTime time.Time // time Squawk was last updated
+
+	Lat      float64 // latitude in degrees
+	Lon      float64 // longitude in degrees
+	OnGround bool    // true if the aircraft reports being on the ground
+
+	// Integrity is the integrity of Lat and Lon, interpreted using
+	// Status. Until an operational status message is received
+	// (StatusTime is zero), ADS-B version 0 is assumed and NUCp is
+	// reported. Rc is +Inf if the integrity cannot be determined.
+	Integrity    adsb.Integrity
+	PositionTime time.Time // time Lat, Lon and Integrity were last updated
+
+	Alt     int64     // barometric altitude in feet
+	AltTime time.Time // time Alt was last updated
+
+	GeomAlt     int64     // GNSS height in feet
+	GeomAltTime time.Time // time GeomAlt was last updated
+
+	Speed        float64   // ground speed in m/s
+	Track        float64   // track angle in degrees clockwise from north
+	VerticalRate float64   // vertical rate in m/s, positive when climbing
+	VelocityTime time.Time // time Speed, Track or VerticalRate were last updated
+
+	Status     adsb.OperationalStatus // ADS-B version and capabilities
+	StatusTime time.Time              // time Status was last updated
+
+	Messages  uint64     // total number of messages received
+	DFCount   [25]uint64 // number of messages received by downlink format
+	FirstSeen time.Time  // time of the first message received
+	LastSeen  time.Time  // time of the most recent message received
+}
+
+// update applies the contents of m to the aircraft state. Fields which
+// are not present in m, or cannot be decoded, are left unchanged.
+func (a *Aircraft) update(m *adsb.Message, ts time.Time, r *adsb.Resolver) {
+	a.Messages++
+	a.LastSeen = ts
+
+	if df, err := m.Raw().DF(); err == nil {
+		a.DFCount[df]++
+
+		if fs, err := m.Raw().FS(); err == nil {
+			switch fs {
+			case 0, 2:
+				a.OnGround = false
+			case 1, 3:
+				a.OnGround = true
+			}
+		}
+	}
+
+	if alt, err := m.Alt(); err == nil {
+		a.Alt = alt
+		a.AltTime = ts
+	}
+
+	if _, ft, err := m.GeometricAlt(); err == nil {
+		a.GeomAlt = ft
+		a.GeomAltTime = ts
+	}
+
+	if call, err := m.Call(); err == nil {
+		a.Call = call
+		a.CallTime = ts
+	}
+
+	if cat, err := m.AircraftDetails(); err == nil {
+		a.Category = cat
+		a.CallTime = ts
+	}
+
+	if sqk, err := m.Sqk(); err == nil {
+		a.Squawk = fmt.Sprintf("%d%d%d%d", sqk[0], sqk[1], sqk[2], sqk[3])
+		a.SquawkTime = ts
+	}
+
+	if st, err := m.OperationalStatus(); err == nil {
+		a.Status = st
+		a.StatusTime = ts
+	}
+
+	a.updateVelocity(m, ts)
+	a.updatePosition(m, ts, r)
+}
+
+// updateVelocity applies airborne or surface velocity information.
+func (a *Aircraft) updateVelocity(m *adsb.Message, ts time.Time) {
+	if spd, trk, err := m.GroundSpeed(); err == nil {
+		a.Speed = spd
+		a.Track = trk
+		a.VelocityTime = ts
+	}
+
+	if vr, err := m.VerticalSpeed(); err == nil {
+		a.VerticalRate = vr
+		a.VelocityTime = ts
+	}
+
+	if spd, trk, err := m.SurfaceSpeed(); err == nil {
+		a.Speed = spd
+		a.Track = trk
+		a.VerticalRate = 0
+		a.VelocityTime = ts
+	}
+}
+
+// updatePosition resolves a position report using r.
+func (a *Aircraft) updatePosition(m *adsb.Message, ts time.Time, r *adsb.Resolver) {
+	_, airborne, err := m.CPR()
+	if err != nil {
+		return
+	}
+
+	a.OnGround = !airborne
+
+	pos, err := r.ResolveMessage(m, ts)
+	if err != nil {
+		return
+	}
+
+	a.Lat = pos.Lat
+	a.Lon = pos.Lon
+	a.PositionTime = ts
+
+	a.Integrity, err = a.Status.Integrity(m)
+	if err != nil {
+		a.Integrity = adsb.Integrity{Rc: math.Inf(1)}
+	}
+}
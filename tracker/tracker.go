@@ -0,0 +1,219 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package tracker provides objects and methods for combining decoded
+// ADS-B and Mode S messages into the current state of each aircraft.
+package tracker
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NeuronInnovations/go-adsb/adsb"
+)
+
+// trackerError is the error type for the tracker library.
+type trackerError struct {
+	msg  string // error message string from this library
+	werr error  // wrapped error from downstream function
+}
+
+// Error returns the string value of an error.
+func (e trackerError) Error() string {
+	if e.werr == nil {
+		return e.msg
+	}
+
+	return e.msg + ": " + e.werr.Error()
+}
+
+// Unwrap returns an underlying error if applicable.
+func (e trackerError) Unwrap() error {
+	return e.werr
+}
+
+// newError returns a new trackerError.
+func newError(w error, m string) trackerError {
+	return trackerError{
+		msg:  m,
+		werr: w,
+	}
+}
+
+// newErrorf returns a new trackerError with a Printf-style message.
+func newErrorf(w error, m string, v ...interface{}) trackerError {
+	return trackerError{
+		msg:  fmt.Sprintf(m, v...),
+		werr: w,
+	}
+}
+
+var errUnknownAircraft = newError(nil, "unknown aircraft")
+
+// ErrUnknownAircraft is returned when a message does not announce its
+// address and the address recovered from the parity field does not
+// belong to an aircraft already being tracked.
+var ErrUnknownAircraft = errUnknownAircraft
+
+// DefaultExpiry is the period after which an aircraft that has not
+// been heard from is removed by Expire, if Tracker.Expiry is zero.
+const DefaultExpiry = 60 * time.Second
+
+// Tracker maintains the state of each aircraft seen in a stream of
+// messages. It must be created with NewTracker() and is safe for
+// concurrent use.
+type Tracker struct {
+	// Expiry is the period after which an aircraft that has not been
+	// heard from is removed by Expire. DefaultExpiry is used if zero.
+	Expiry time.Duration
+
+	// Resolver pairs the position reports of each aircraft. Setting its
+	// Reference to the receiver position enables global decoding of
+	// surface positions.
+	Resolver *adsb.Resolver
+
+	mu       sync.Mutex
+	aircraft map[uint64]*Aircraft
+}
+
+// NewTracker returns an empty Tracker.
+func NewTracker() *Tracker {
+	t := new(Tracker)
+	t.aircraft = make(map[uint64]*Aircraft)
+	t.Resolver = adsb.NewResolver()
+
+	return t
+}
+
+// Update applies the contents of m, received at ts, to the state of
+// the transmitting aircraft and returns a copy of the updated state.
+//
+// Addresses recovered from the parity field of surveillance and Comm-B
+// replies are only trusted when the aircraft is already known from an
+// all-call reply or extended squitter. Otherwise the returned error
+// wraps ErrUnknownAircraft.
+func (t *Tracker) Update(m *adsb.Message, ts time.Time) (Aircraft, error) {
+	icao, announced, err := address(m)
+	if err != nil {
+		return Aircraft{}, newError(err, "error retrieving address")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	a, ok := t.aircraft[icao]
+	if !ok {
+		if !announced {
+			return Aircraft{}, newErrorf(ErrUnknownAircraft, "address %06x", icao)
+		}
+
+		a = &Aircraft{ICAO: icao, FirstSeen: ts}
+		t.aircraft[icao] = a
+	}
+
+	a.update(m, ts, t.Resolver)
+
+	return *a, nil
+}
+
+// Aircraft returns a copy of the state of the aircraft with the given
+// address, and whether it is being tracked.
+func (t *Tracker) Aircraft(icao uint64) (Aircraft, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	a, ok := t.aircraft[icao]
+	if !ok {
+		return Aircraft{}, false
+	}
+
+	return *a, true
+}
+
+// All returns a copy of the state of every tracked aircraft, ordered by
+// address.
+func (t *Tracker) All() []Aircraft {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	all := make([]Aircraft, 0, len(t.aircraft))
+	for _, a := range t.aircraft {
+		all = append(all, *a)
+	}
+
+	sort.Slice(all, func(i, j int) bool { return all[i].ICAO < all[j].ICAO })
+
+	return all
+}
+
+// Len returns the number of tracked aircraft.
+func (t *Tracker) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return len(t.aircraft)
+}
+
+// Expire removes aircraft which have not been heard from within the
+// expiry period before now, and returns the number removed.
+func (t *Tracker) Expire(now time.Time) int {
+	exp := t.Expiry
+	if exp == 0 {
+		exp = DefaultExpiry
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var n int
+
+	for icao, a := range t.aircraft {
+		if now.Sub(a.LastSeen) > exp {
+			delete(t.aircraft, icao)
+			t.Resolver.Forget(icao)
+			n++
+		}
+	}
+
+	return n
+}
+
+// address returns the ICAO address of m, and whether the address was
+// announced in the message rather than recovered from the parity.
+func address(m *adsb.Message) (uint64, bool, error) {
+	aa, err := m.Raw().AA()
+	if err == nil {
+		return aa, true, nil
+	} else if !errors.Is(err, adsb.ErrNotAvailable) {
+		return 0, false, err
+	}
+
+	icao, err := m.ICAO()
+	if err != nil {
+		return 0, false, err
+	}
+
+	return icao, false, nil
+}
@@ -0,0 +1,279 @@
+// Copyright 2020 Collin Kreklow
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS
+// BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN
+// ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tracker_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/NeuronInnovations/go-adsb/adsb"
+	"github.com/NeuronInnovations/go-adsb/adsbtype"
+	"github.com/NeuronInnovations/go-adsb/tracker"
+)
+
+var t0 = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestTracker(t *testing.T) {
+	t.Run("Identity", testTrackerIdentity)
+	t.Run("Position", testTrackerPosition)
+	t.Run("PositionStale", testTrackerPositionStale)
+	t.Run("PositionGNSS", testTrackerPositionGNSS)
+	t.Run("PositionIntegrity", testTrackerPositionIntegrity)
+	t.Run("PositionIntegrityError", testTrackerPositionIntegrityError)
+	t.Run("PositionSlowSurface", testTrackerPositionSlowSurface)
+	t.Run("Velocity", testTrackerVelocity)
+	t.Run("UnknownAircraft", testTrackerUnknown)
+	t.Run("Expire", testTrackerExpire)
+}
+
+func testTrackerIdentity(t *testing.T) {
+	tr := tracker.NewTracker()
+
+	a := update(t, tr, "8dacf84e23101332cf3ca037ef13", t0)
+
+	if a.ICAO != 0xacf84e {
+		t.Errorf("ICAO: received %06x, expected %06x", a.ICAO, 0xacf84e)
+	}
+
+	if a.Call != "DAL2332" {
+		t.Errorf("Call: received %s, expected %s", a.Call, "DAL2332")
+	}
+
+	if a.Category != "Medium 2 (34000 kg to 136000 kg)" {
+		t.Errorf("Category: received %s", a.Category)
+	}
+
+	if a.Messages != 1 || a.DFCount[17] != 1 {
+		t.Errorf("Messages: received %d/%d, expected 1/1", a.Messages, a.DFCount[17])
+	}
+
+	if !a.FirstSeen.Equal(t0) || !a.LastSeen.Equal(t0) {
+		t.Errorf("received unexpected times %s %s", a.FirstSeen, a.LastSeen)
+	}
+}
+
+func testTrackerPosition(t *testing.T) {
+	tr := tracker.NewTracker()
+
+	a := update(t, tr, "8da8028758ab0028de078689d437", t0)
+	if !a.PositionTime.IsZero() {
+		t.Error("position decoded from a single report")
+	}
+
+	if a.Alt != 33000 {
+		t.Errorf("Alt: received %d, expected %d", a.Alt, 33000)
+	}
+
+	a = update(t, tr, "8da8028758ab07b0b8876e81eb25", t0.Add(time.Second))
+	if a.PositionTime.IsZero() {
+		t.Fatal("position not decoded")
+	}
+
+	if math.Abs(a.Lat-42.23945229) > 1e-6 || math.Abs(a.Lon+89.87851165) > 1e-6 {
+		t.Errorf("received %f, %f, expected %f, %f", a.Lat, a.Lon, 42.23945229, -89.87851165)
+	}
+
+	if a.OnGround {
+		t.Error("OnGround: received true, expected false")
+	}
+}
+
+func testTrackerPositionStale(t *testing.T) {
+	tr := tracker.NewTracker()
+
+	update(t, tr, "8da8028758ab0028de078689d437", t0)
+
+	a := update(t, tr, "8da8028758ab07b0b8876e81eb25", t0.Add(11*time.Second))
+	if !a.PositionTime.IsZero() {
+		t.Error("position decoded from reports outside the pairing window")
+	}
+}
+
+func testTrackerPositionGNSS(t *testing.T) {
+	tr := tracker.NewTracker()
+
+	update(t, tr, "8da80287a07fa028de0786469665", t0)
+
+	a := update(t, tr, "8da80287a87fd7b0b8876e3e1c42", t0.Add(time.Second))
+	if a.PositionTime.IsZero() {
+		t.Fatal("position not decoded")
+	}
+
+	if math.Abs(a.Lat-42.23945229) > 1e-6 || math.Abs(a.Lon+89.87851165) > 1e-6 {
+		t.Errorf("received %f, %f, expected %f, %f", a.Lat, a.Lon, 42.23945229, -89.87851165)
+	}
+
+	if a.GeomAlt != 6709 || a.GeomAltTime != t0.Add(time.Second) {
+		t.Errorf("GeomAlt: received %d at %s, expected %d", a.GeomAlt, a.GeomAltTime, 6709)
+	}
+
+	if !a.AltTime.IsZero() {
+		t.Error("barometric altitude populated from GNSS height")
+	}
+}
+
+func testTrackerPositionIntegrity(t *testing.T) {
+	tr := tracker.NewTracker()
+
+	a := update(t, tr, "8da80287f80020000049b8d22e19", t0)
+	if a.Status.Version != adsbtype.VER2 || a.StatusTime != t0 {
+		t.Errorf("Status: received version %d at %s", a.Status.Version, a.StatusTime)
+	}
+
+	update(t, tr, "8da8028758ab0028de078689d437", t0)
+
+	a = update(t, tr, "8da8028758ab07b0b8876e81eb25", t0.Add(time.Second))
+	if a.PositionTime.IsZero() {
+		t.Fatal("position not decoded")
+	}
+
+	if a.Integrity.NIC != 8 || a.Integrity.Rc != 185.2 {
+		t.Errorf("Integrity: received %+v, expected NIC 8 Rc 185.2", a.Integrity)
+	}
+}
+
+func testTrackerPositionIntegrityError(t *testing.T) {
+	tr := tracker.NewTracker()
+
+	// version 2 with NIC supplement A, which is invalid for type code 11
+	// without NIC supplement B
+	update(t, tr, "8da80287f80020000059b832f619", t0)
+	update(t, tr, "8da8028758ab0028de078689d437", t0)
+
+	a := update(t, tr, "8da8028758ab07b0b8876e81eb25", t0.Add(time.Second))
+	if a.PositionTime.IsZero() {
+		t.Fatal("position not decoded")
+	}
+
+	if a.Integrity.NIC != 0 || !math.IsInf(a.Integrity.Rc, 1) {
+		t.Errorf("Integrity: received %+v, expected unknown Rc", a.Integrity)
+	}
+}
+
+func testTrackerPositionSlowSurface(t *testing.T) {
+	tr := tracker.NewTracker()
+	tr.Resolver.Reference = []float64{51.99, 4.375}
+
+	// surface reports moving 25 kt or slower use the longer pairing
+	// window
+	update(t, tr, "8c4841753aab238733c8cd4020b1", t0)
+
+	a := update(t, tr, "8c4841753a8a35323faebdac702d", t0.Add(30*time.Second))
+	if a.PositionTime.IsZero() {
+		t.Fatal("position not decoded")
+	}
+
+	if math.Abs(a.Lat-52.32061) > 1e-5 || math.Abs(a.Lon-4.73473) > 1e-5 {
+		t.Errorf("received %f, %f, expected %f, %f", a.Lat, a.Lon, 52.32061, 4.73473)
+	}
+
+	if !a.OnGround {
+		t.Error("OnGround: received false, expected true")
+	}
+}
+
+func testTrackerVelocity(t *testing.T) {
+	tr := tracker.NewTracker()
+
+	a := update(t, tr, "8dc054bd9908dc85986c0c2ebe76", t0)
+
+	if math.Abs(a.Speed-114.8145) > 0.001 {
+		t.Errorf("Speed: received %f, expected %f", a.Speed, 114.8145)
+	}
+
+	if math.Abs(a.Track-101.1085) > 0.001 {
+		t.Errorf("Track: received %f, expected %f", a.Track, 101.1085)
+	}
+
+	if math.Abs(a.VerticalRate+8.45312) > 0.001 {
+		t.Errorf("VerticalRate: received %f, expected %f", a.VerticalRate, -8.45312)
+	}
+}
+
+func testTrackerUnknown(t *testing.T) {
+	tr := tracker.NewTracker()
+
+	m := message(t, "20001910bc45e9")
+
+	_, err := tr.Update(m, t0)
+	if !errors.Is(err, tracker.ErrUnknownAircraft) {
+		t.Fatalf("expected ErrUnknownAircraft, received %v", err)
+	}
+
+	if tr.Len() != 0 {
+		t.Errorf("expected 0 aircraft, received %d", tr.Len())
+	}
+}
+
+func testTrackerExpire(t *testing.T) {
+	tr := tracker.NewTracker()
+	tr.Expiry = 30 * time.Second
+
+	update(t, tr, "8dacf84e23101332cf3ca037ef13", t0)
+	update(t, tr, "8da8028758ab0028de078689d437", t0.Add(20*time.Second))
+
+	if n := tr.Expire(t0.Add(40 * time.Second)); n != 1 {
+		t.Errorf("expected 1 expired, received %d", n)
+	}
+
+	all := tr.All()
+	if len(all) != 1 || all[0].ICAO != 0xa80287 {
+		t.Errorf("received unexpected aircraft %v", all)
+	}
+
+	if _, ok := tr.Aircraft(0xacf84e); ok {
+		t.Error("expired aircraft still tracked")
+	}
+}
+
+func message(t *testing.T, s string) *adsb.Message {
+	t.Helper()
+
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	m := new(adsb.Message)
+
+	err = m.UnmarshalBinary(b)
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	return m
+}
+
+func update(t *testing.T, tr *tracker.Tracker, s string, ts time.Time) tracker.Aircraft {
+	t.Helper()
+
+	a, err := tr.Update(message(t, s), ts)
+	if err != nil {
+		t.Fatal("received unexpected error:", err)
+	}
+
+	return a
+}